@@ -0,0 +1,38 @@
+// Command mcp-registry provides offline tooling for the registry
+// validators that doesn't belong in the HTTP service itself, such as
+// building the snapshot bundles consumed by
+// config.RegistryValidationModeSnapshot.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "snapshot":
+		err = runSnapshot(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "mcp-registry:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: mcp-registry <command> [arguments]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	fmt.Fprintln(os.Stderr, "  snapshot build -out <bundle.tar.gz> <server.json>...")
+	fmt.Fprintln(os.Stderr, "      Build an offline snapshot bundle for config.RegistryValidationModeSnapshot")
+	fmt.Fprintln(os.Stderr, "      from a list of published server.json documents.")
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/snapshot"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// runSnapshot implements the "snapshot" command group. The only
+// subcommand today is "build"; it's a group rather than a flat command
+// so future additions (e.g. "snapshot inspect") have somewhere to live.
+func runSnapshot(args []string) error {
+	if len(args) == 0 || args[0] != "build" {
+		return fmt.Errorf("usage: mcp-registry snapshot build -out <bundle.tar.gz> <server.json>...")
+	}
+	return runSnapshotBuild(args[1:])
+}
+
+// runSnapshotBuild reads each server.json given on the command line and
+// captures its packages' registry type, identifier, version and
+// FileSHA256 (when declared) into a snapshot bundle. It does not contact
+// any upstream registry itself: FileSHA256 is whatever the source
+// document already carries, so packages published without one simply
+// won't have a digest to verify against in snapshot mode.
+func runSnapshotBuild(args []string) error {
+	fs := flag.NewFlagSet("snapshot build", flag.ContinueOnError)
+	out := fs.String("out", "", "path to write the snapshot bundle to")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *out == "" {
+		return fmt.Errorf("-out is required")
+	}
+	serverJSONPaths := fs.Args()
+	if len(serverJSONPaths) == 0 {
+		return fmt.Errorf("at least one server.json path is required")
+	}
+
+	var entries []snapshot.Entry
+	for _, path := range serverJSONPaths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("read %s: %w", path, err)
+		}
+		var s apiv0.ServerJSON
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return fmt.Errorf("parse %s: %w", path, err)
+		}
+		for _, pkg := range s.Packages {
+			entries = append(entries, snapshot.Entry{
+				RegistryType: pkg.RegistryType,
+				Identifier:   pkg.Identifier,
+				Version:      pkg.Version,
+				SHA256:       pkg.FileSHA256,
+			})
+		}
+	}
+
+	if dir := filepath.Dir(*out); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return fmt.Errorf("create output directory: %w", err)
+		}
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return fmt.Errorf("create %s: %w", *out, err)
+	}
+	defer f.Close()
+
+	if err := snapshot.Build(f, entries); err != nil {
+		return fmt.Errorf("build bundle: %w", err)
+	}
+	fmt.Printf("wrote %d package entries from %d server.json file(s) to %s\n", len(entries), len(serverJSONPaths), *out)
+	return nil
+}
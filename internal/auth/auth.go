@@ -0,0 +1,22 @@
+// Package auth issues and verifies the registry JWTs that gate
+// publishing: it defines the permission/scope model (a Docker Registry
+// v2-style "server:<name-pattern>:<action>" scope), and the JWTManager
+// that signs and validates tokens carrying those permissions.
+package auth
+
+// Method identifies how a client proved its identity before being
+// issued a registry JWT, so a token's claims can be traced back to the
+// credential that produced it.
+type Method string
+
+const (
+	// MethodNone is used when an operator has configured a wildcard
+	// permission for anonymous publishes (e.g. local development), with
+	// no external credential to verify.
+	MethodNone Method = "none"
+
+	// MethodGitHubAT identifies a client that authenticated with a
+	// GitHub Actions OIDC-backed access token, scoped to the
+	// repositories that token's workflow run is permitted to act as.
+	MethodGitHubAT Method = "github-at"
+)
@@ -0,0 +1,185 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// authCodeTTL is how long an authorization code issued by /v0/authorize
+// remains exchangeable, mirroring the short lifetime the OAuth 2.0
+// Authorization Code grant (RFC 6749 §4.1.2) recommends.
+const authCodeTTL = 60 * time.Second
+
+var (
+	// ErrAuthCodeNotFound is returned when a code doesn't match any
+	// issued authorization code, whether because it was never issued,
+	// already consumed, or the store has pruned it.
+	ErrAuthCodeNotFound = errors.New("auth: authorization code not found or already used")
+
+	// ErrAuthCodeExpired is returned when a code is exchanged after its
+	// authCodeTTL has elapsed.
+	ErrAuthCodeExpired = errors.New("auth: authorization code has expired")
+
+	// ErrAuthCodeClientMismatch is returned when the client_id or
+	// redirect_uri presented at exchange time doesn't match the one the
+	// code was issued for, as RFC 6749 §4.1.3 requires.
+	ErrAuthCodeClientMismatch = errors.New("auth: client_id or redirect_uri does not match the authorization request")
+
+	// ErrPKCEVerifierMismatch is returned when code_verifier's SHA-256
+	// doesn't match the code_challenge recorded at authorization time.
+	ErrPKCEVerifierMismatch = errors.New("auth: code_verifier does not match code_challenge")
+
+	// ErrUnsupportedChallengeMethod is returned for any
+	// code_challenge_method other than "S256", the only method this
+	// package implements (RFC 7636 discourages "plain").
+	ErrUnsupportedChallengeMethod = errors.New("auth: unsupported code_challenge_method")
+
+	// ErrScopeNotGranted is returned when the scope requested at
+	// exchange time isn't covered by the scope approved at
+	// authorization time.
+	ErrScopeNotGranted = errors.New("auth: requested scope exceeds the scope granted at authorization")
+)
+
+// AuthCode is an issued, not-yet-exchanged authorization code from the
+// /v0/authorize + PKCE flow.
+type AuthCode struct {
+	Code                string
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	AuthMethod          Method
+	AuthMethodSubject   string
+	ExpiresAt           time.Time
+}
+
+// AuthCodeStore persists authorization codes between /v0/authorize
+// issuing one and /v0/token exchanging it. Consume must be atomic: a
+// code must be retrievable by exactly one caller, so a concurrent or
+// subsequent replay of the same code observes ErrAuthCodeNotFound
+// rather than the same AuthCode twice. No concrete implementation
+// ships in this package; a production registry backs this with its
+// database (an "auth_codes" table keyed by Code, with a consumed flag
+// set inside the same transaction that reads the row).
+type AuthCodeStore interface {
+	// Create persists a newly issued code. It returns an error if Code
+	// is already in use.
+	Create(ctx context.Context, code AuthCode) error
+
+	// Consume atomically retrieves and invalidates code, so it can
+	// never be exchanged again. It returns ErrAuthCodeNotFound if code
+	// is unknown or was already consumed.
+	Consume(ctx context.Context, code string) (*AuthCode, error)
+}
+
+// AuthCodeManager issues and exchanges authorization codes for the
+// /v0/authorize + /v0/token PKCE flow, backed by an AuthCodeStore.
+type AuthCodeManager struct {
+	store AuthCodeStore
+}
+
+// NewAuthCodeManager builds an AuthCodeManager backed by store.
+func NewAuthCodeManager(store AuthCodeStore) *AuthCodeManager {
+	return &AuthCodeManager{store: store}
+}
+
+// IssueCodeRequest is the approved authorization request /v0/authorize
+// turns into an opaque code after the human approves it.
+type IssueCodeRequest struct {
+	ClientID            string
+	RedirectURI         string
+	Scope               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	AuthMethod          Method
+	AuthMethodSubject   string
+}
+
+// IssueCode generates a single-use opaque code for req, valid for
+// authCodeTTL, and persists it via the manager's store.
+func (m *AuthCodeManager) IssueCode(ctx context.Context, req IssueCodeRequest) (string, error) {
+	if req.CodeChallengeMethod != "S256" {
+		return "", fmt.Errorf("%w: %q", ErrUnsupportedChallengeMethod, req.CodeChallengeMethod)
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("auth: generate authorization code: %w", err)
+	}
+	code := base64.RawURLEncoding.EncodeToString(buf)
+
+	err := m.store.Create(ctx, AuthCode{
+		Code:                code,
+		ClientID:            req.ClientID,
+		RedirectURI:         req.RedirectURI,
+		Scope:               req.Scope,
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		AuthMethod:          req.AuthMethod,
+		AuthMethodSubject:   req.AuthMethodSubject,
+		ExpiresAt:           time.Now().Add(authCodeTTL),
+	})
+	if err != nil {
+		return "", err
+	}
+	return code, nil
+}
+
+// ExchangeCodeRequest is a /v0/token request's "authorization_code"
+// grant parameters.
+type ExchangeCodeRequest struct {
+	Code         string
+	ClientID     string
+	RedirectURI  string
+	CodeVerifier string
+
+	// Scope, if set, narrows the token to a subset of the scope that
+	// was approved at authorization time; every requested permission
+	// must be covered by a granted one (see Permission.Covers). Left
+	// empty, the full granted scope is returned.
+	Scope string
+}
+
+// ExchangeCode redeems req.Code for the Permissions a registry JWT
+// should be issued with. It consumes the code from the store first, so
+// a replayed or concurrently-raced exchange always fails even if the
+// rest of the request is otherwise valid.
+func (m *AuthCodeManager) ExchangeCode(ctx context.Context, req ExchangeCodeRequest) ([]Permission, error) {
+	ac, err := m.store.Consume(ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(ac.ExpiresAt) {
+		return nil, ErrAuthCodeExpired
+	}
+	if ac.ClientID != req.ClientID || ac.RedirectURI != req.RedirectURI {
+		return nil, ErrAuthCodeClientMismatch
+	}
+	if err := VerifyPKCEChallenge(ac.CodeChallengeMethod, ac.CodeChallenge, req.CodeVerifier); err != nil {
+		return nil, err
+	}
+
+	granted, err := ParseScope(ac.Scope)
+	if err != nil {
+		return nil, err
+	}
+	if req.Scope == "" {
+		return granted, nil
+	}
+
+	requested, err := ParseScope(req.Scope)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range requested {
+		if !AnyCovers(granted, r) {
+			return nil, fmt.Errorf("%w: %s", ErrScopeNotGranted, r.Scope())
+		}
+	}
+	return requested, nil
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memAuthCodeStore is a minimal in-memory AuthCodeStore for tests; it is
+// not the production implementation (see AuthCodeStore's doc comment).
+type memAuthCodeStore struct {
+	mu    sync.Mutex
+	codes map[string]AuthCode
+}
+
+func newMemAuthCodeStore() *memAuthCodeStore {
+	return &memAuthCodeStore{codes: map[string]AuthCode{}}
+}
+
+func (s *memAuthCodeStore) Create(_ context.Context, code AuthCode) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, exists := s.codes[code.Code]; exists {
+		return ErrAuthCodeNotFound
+	}
+	s.codes[code.Code] = code
+	return nil
+}
+
+func (s *memAuthCodeStore) Consume(_ context.Context, code string) (*AuthCode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ac, ok := s.codes[code]
+	if !ok {
+		return nil, ErrAuthCodeNotFound
+	}
+	delete(s.codes, code)
+	return &ac, nil
+}
+
+func issueTestCode(t *testing.T, m *AuthCodeManager, scope string) (code, verifier string) {
+	t.Helper()
+	verifier = "a-secret-code-verifier-at-least-43-chars-long"
+	code, err := m.IssueCode(context.Background(), IssueCodeRequest{
+		ClientID:            "https://ide.example.com",
+		RedirectURI:         "https://ide.example.com/callback",
+		Scope:               scope,
+		CodeChallenge:       challengeFor(verifier),
+		CodeChallengeMethod: "S256",
+		AuthMethod:          MethodGitHubAT,
+		AuthMethodSubject:   "octocat",
+	})
+	require.NoError(t, err)
+	return code, verifier
+}
+
+func TestAuthCodeManager_RoundTrip(t *testing.T) {
+	m := NewAuthCodeManager(newMemAuthCodeStore())
+	code, verifier := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	perms, err := m.ExchangeCode(context.Background(), ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://ide.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: verifier,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{{Action: PermissionActionPublish, ResourcePattern: "io.github.example/*"}}, perms)
+}
+
+func TestAuthCodeManager_PKCEMismatch(t *testing.T) {
+	m := NewAuthCodeManager(newMemAuthCodeStore())
+	code, _ := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	_, err := m.ExchangeCode(context.Background(), ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://ide.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: "wrong-verifier",
+	})
+	assert.ErrorIs(t, err, ErrPKCEVerifierMismatch)
+}
+
+func TestAuthCodeManager_Expired(t *testing.T) {
+	store := newMemAuthCodeStore()
+	m := NewAuthCodeManager(store)
+	code, verifier := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	stored := store.codes[code]
+	stored.ExpiresAt = stored.ExpiresAt.Add(-2 * authCodeTTL)
+	store.codes[code] = stored
+
+	_, err := m.ExchangeCode(context.Background(), ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://ide.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: verifier,
+	})
+	assert.ErrorIs(t, err, ErrAuthCodeExpired)
+}
+
+func TestAuthCodeManager_Replay(t *testing.T) {
+	m := NewAuthCodeManager(newMemAuthCodeStore())
+	code, verifier := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	req := ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://ide.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: verifier,
+	}
+	_, err := m.ExchangeCode(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = m.ExchangeCode(context.Background(), req)
+	assert.ErrorIs(t, err, ErrAuthCodeNotFound)
+}
+
+func TestAuthCodeManager_ClientMismatch(t *testing.T) {
+	m := NewAuthCodeManager(newMemAuthCodeStore())
+	code, verifier := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	_, err := m.ExchangeCode(context.Background(), ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://attacker.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: verifier,
+	})
+	assert.ErrorIs(t, err, ErrAuthCodeClientMismatch)
+}
+
+func TestAuthCodeManager_ScopeNarrowing(t *testing.T) {
+	m := NewAuthCodeManager(newMemAuthCodeStore())
+	code, verifier := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	perms, err := m.ExchangeCode(context.Background(), ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://ide.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: verifier,
+		Scope:        "server:io.github.example/foo-server:publish",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{{Action: PermissionActionPublish, ResourcePattern: "io.github.example/foo-server"}}, perms)
+}
+
+func TestAuthCodeManager_ScopeExceedsGranted(t *testing.T) {
+	m := NewAuthCodeManager(newMemAuthCodeStore())
+	code, verifier := issueTestCode(t, m, "server:io.github.example/*:publish")
+
+	_, err := m.ExchangeCode(context.Background(), ExchangeCodeRequest{
+		Code:         code,
+		ClientID:     "https://ide.example.com",
+		RedirectURI:  "https://ide.example.com/callback",
+		CodeVerifier: verifier,
+		Scope:        "server:*:publish",
+	})
+	assert.ErrorIs(t, err, ErrScopeNotGranted)
+}
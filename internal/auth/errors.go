@@ -0,0 +1,22 @@
+package auth
+
+import "errors"
+
+var (
+	// ErrInvalidScope is returned when a scope string doesn't match the
+	// "server:<name-pattern>:<action>[,<action>]" grammar ParseScope
+	// expects.
+	ErrInvalidScope = errors.New("invalid scope string")
+
+	// ErrMissingAuthHeader is returned when a request has no
+	// Authorization header, or one that isn't shaped "Bearer <token>".
+	ErrMissingAuthHeader = errors.New("invalid authorization header format")
+
+	// ErrInvalidToken is returned when a registry JWT's signature
+	// doesn't verify, or it has expired.
+	ErrInvalidToken = errors.New("invalid or expired registry JWT token")
+
+	// ErrPermissionDenied is returned when a token's Permissions don't
+	// grant the action being attempted on the requested resource.
+	ErrPermissionDenied = errors.New("permission denied")
+)
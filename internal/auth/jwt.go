@@ -0,0 +1,98 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/modelcontextprotocol/registry/internal/config"
+)
+
+// tokenTTL is how long an issued registry JWT remains valid. Clients are
+// expected to fetch a fresh token per publish rather than cache one for
+// long-running use, the same way Docker Distribution v2 bearer tokens
+// work.
+const tokenTTL = 10 * time.Minute
+
+// JWTClaims are the registry-issued token's claims: who authenticated,
+// how, and what they're allowed to do.
+type JWTClaims struct {
+	jwt.RegisteredClaims
+
+	AuthMethod Method `json:"auth_method"`
+
+	// AuthMethodSubject identifies the authenticated principal within
+	// AuthMethod's namespace, e.g. a GitHub login for MethodGitHubAT. It
+	// is empty for MethodNone.
+	AuthMethodSubject string `json:"auth_method_subject,omitempty"`
+
+	Permissions []Permission `json:"permissions"`
+}
+
+// TokenResponse is returned to a client exchanging credentials (or a
+// Docker Registry v2-style scope request) for a registry JWT.
+type TokenResponse struct {
+	RegistryToken string    `json:"registry_token"`
+	ExpiresAt     time.Time `json:"expires_at"`
+}
+
+// JWTManager signs and verifies registry JWTs with an ed25519 key pair
+// derived from config.Config.JWTPrivateKey.
+type JWTManager struct {
+	privateKey ed25519.PrivateKey
+	publicKey  ed25519.PublicKey
+}
+
+// NewJWTManager builds a JWTManager from cfg.JWTPrivateKey, the
+// hex-encoded ed25519 seed used to sign and verify registry tokens. It
+// panics if the seed is missing or malformed, since that's a startup
+// misconfiguration rather than something a request can trigger.
+func NewJWTManager(cfg *config.Config) *JWTManager {
+	seed, err := hex.DecodeString(cfg.JWTPrivateKey)
+	if err != nil || len(seed) != ed25519.SeedSize {
+		panic(fmt.Sprintf("auth: JWTPrivateKey must be a hex-encoded %d-byte ed25519 seed", ed25519.SeedSize))
+	}
+	priv := ed25519.NewKeyFromSeed(seed)
+	return &JWTManager{
+		privateKey: priv,
+		publicKey:  priv.Public().(ed25519.PublicKey),
+	}
+}
+
+// GenerateTokenResponse signs claims into a registry JWT valid for
+// tokenTTL, stamping its IssuedAt/ExpiresAt itself so callers can't mint
+// a longer-lived token by setting those fields on the input claims.
+func (m *JWTManager) GenerateTokenResponse(_ context.Context, claims JWTClaims) (TokenResponse, error) {
+	now := time.Now()
+	expiresAt := now.Add(tokenTTL)
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims).SignedString(m.privateKey)
+	if err != nil {
+		return TokenResponse{}, fmt.Errorf("sign registry token: %w", err)
+	}
+	return TokenResponse{RegistryToken: signed, ExpiresAt: expiresAt}, nil
+}
+
+// ValidateToken parses and verifies a registry JWT, returning its claims
+// if the signature is valid and it hasn't expired.
+func (m *JWTManager) ValidateToken(_ context.Context, tokenString string) (*JWTClaims, error) {
+	var claims JWTClaims
+	token, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, fmt.Errorf("%w: unexpected signing method %v", ErrInvalidToken, t.Header["alg"])
+		}
+		return m.publicKey, nil
+	})
+	if err != nil || !token.Valid {
+		return nil, ErrInvalidToken
+	}
+	return &claims, nil
+}
@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/config"
+)
+
+func testConfig(t *testing.T) *config.Config {
+	t.Helper()
+	seed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(seed)
+	require.NoError(t, err)
+	return &config.Config{JWTPrivateKey: hex.EncodeToString(seed)}
+}
+
+func TestJWTManager_RoundTrip(t *testing.T) {
+	manager := NewJWTManager(testConfig(t))
+	claims := JWTClaims{
+		AuthMethod:        MethodGitHubAT,
+		AuthMethodSubject: "example",
+		Permissions: []Permission{
+			{Action: PermissionActionPublish, ResourcePattern: "io.github.example/*"},
+		},
+	}
+
+	resp, err := manager.GenerateTokenResponse(context.Background(), claims)
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.RegistryToken)
+
+	got, err := manager.ValidateToken(context.Background(), resp.RegistryToken)
+	require.NoError(t, err)
+	assert.Equal(t, claims.AuthMethod, got.AuthMethod)
+	assert.Equal(t, claims.AuthMethodSubject, got.AuthMethodSubject)
+	assert.Equal(t, claims.Permissions, got.Permissions)
+}
+
+func TestJWTManager_RejectsGarbageToken(t *testing.T) {
+	manager := NewJWTManager(testConfig(t))
+	_, err := manager.ValidateToken(context.Background(), "invalidToken")
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestJWTManager_RejectsTokenFromDifferentKey(t *testing.T) {
+	manager := NewJWTManager(testConfig(t))
+	other := NewJWTManager(testConfig(t))
+
+	resp, err := other.GenerateTokenResponse(context.Background(), JWTClaims{AuthMethod: MethodNone})
+	require.NoError(t, err)
+
+	_, err = manager.ValidateToken(context.Background(), resp.RegistryToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
@@ -0,0 +1,76 @@
+package auth
+
+import "strings"
+
+// PermissionAction is an action a Permission grants over every resource
+// matching its ResourcePattern.
+type PermissionAction string
+
+const (
+	// PermissionActionPublish grants the ability to publish new versions
+	// of a server matching the permission's ResourcePattern.
+	PermissionActionPublish PermissionAction = "publish"
+)
+
+// Permission grants Action over every server name matching
+// ResourcePattern. A pattern ending in "*" matches any name sharing that
+// prefix (e.g. "io.github.example/*" matches "io.github.example/foo");
+// the literal "*" matches every name.
+type Permission struct {
+	Action          PermissionAction `json:"action"`
+	ResourcePattern string           `json:"resource_pattern"`
+}
+
+// Matches reports whether p grants action over resource.
+func (p Permission) Matches(action PermissionAction, resource string) bool {
+	return p.Action == action && matchesResourcePattern(p.ResourcePattern, resource)
+}
+
+func matchesResourcePattern(pattern, resource string) bool {
+	if pattern == "*" {
+		return true
+	}
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(resource, prefix)
+	}
+	return pattern == resource
+}
+
+// AnyMatches reports whether any permission in perms grants action over
+// resource.
+func AnyMatches(perms []Permission, action PermissionAction, resource string) bool {
+	for _, p := range perms {
+		if p.Matches(action, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// Covers reports whether p grants everything other does: same action,
+// and other's ResourcePattern names a subset of the names p's does. This
+// is how scope narrowing at /v0/token exchange time checks that a
+// requested scope doesn't exceed what was approved at authorization
+// time, as opposed to Matches, which checks a single concrete resource
+// name rather than another pattern.
+func (p Permission) Covers(other Permission) bool {
+	if p.Action != other.Action {
+		return false
+	}
+	prefix, wildcard := strings.CutSuffix(p.ResourcePattern, "*")
+	if !wildcard {
+		return p.ResourcePattern == other.ResourcePattern
+	}
+	otherPrefix, _ := strings.CutSuffix(other.ResourcePattern, "*")
+	return strings.HasPrefix(otherPrefix, prefix)
+}
+
+// AnyCovers reports whether any permission in perms covers other.
+func AnyCovers(perms []Permission, other Permission) bool {
+	for _, p := range perms {
+		if p.Covers(other) {
+			return true
+		}
+	}
+	return false
+}
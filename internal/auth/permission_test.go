@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermission_Matches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		perm     Permission
+		action   PermissionAction
+		resource string
+		want     bool
+	}{
+		{"exact match", Permission{PermissionActionPublish, "io.github.example/foo"}, PermissionActionPublish, "io.github.example/foo", true},
+		{"prefix wildcard match", Permission{PermissionActionPublish, "io.github.example/*"}, PermissionActionPublish, "io.github.example/foo", true},
+		{"global wildcard match", Permission{PermissionActionPublish, "*"}, PermissionActionPublish, "anything/at/all", true},
+		{"wrong action", Permission{PermissionActionPublish, "*"}, "delete", "anything", false},
+		{"prefix mismatch", Permission{PermissionActionPublish, "io.github.example/*"}, PermissionActionPublish, "io.github.other/foo", false},
+		{"exact mismatch", Permission{PermissionActionPublish, "io.github.example/foo"}, PermissionActionPublish, "io.github.example/bar", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.perm.Matches(tc.action, tc.resource))
+		})
+	}
+}
+
+func TestAnyMatches(t *testing.T) {
+	perms := []Permission{
+		{Action: PermissionActionPublish, ResourcePattern: "io.github.example/*"},
+	}
+	assert.True(t, AnyMatches(perms, PermissionActionPublish, "io.github.example/foo"))
+	assert.False(t, AnyMatches(perms, PermissionActionPublish, "io.github.other/foo"))
+}
+
+func TestPermission_Covers(t *testing.T) {
+	testCases := []struct {
+		name    string
+		granted Permission
+		want    Permission
+		covers  bool
+	}{
+		{"wildcard covers a concrete name under it", Permission{PermissionActionPublish, "io.github.example/*"}, Permission{PermissionActionPublish, "io.github.example/foo-server"}, true},
+		{"wildcard covers itself", Permission{PermissionActionPublish, "io.github.example/*"}, Permission{PermissionActionPublish, "io.github.example/*"}, true},
+		{"wildcard does not cover a different namespace", Permission{PermissionActionPublish, "io.github.example/*"}, Permission{PermissionActionPublish, "io.github.other/foo"}, false},
+		{"global wildcard covers anything", Permission{PermissionActionPublish, "*"}, Permission{PermissionActionPublish, "anything/at/all"}, true},
+		{"narrower wildcard does not cover a broader one", Permission{PermissionActionPublish, "io.github.example/foo-*"}, Permission{PermissionActionPublish, "io.github.example/*"}, false},
+		{"exact pattern only covers itself", Permission{PermissionActionPublish, "io.github.example/foo"}, Permission{PermissionActionPublish, "io.github.example/foo"}, true},
+		{"exact pattern does not cover a wildcard", Permission{PermissionActionPublish, "io.github.example/foo"}, Permission{PermissionActionPublish, "io.github.example/*"}, false},
+		{"wrong action", Permission{PermissionActionPublish, "*"}, Permission{"delete", "anything"}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.covers, tc.granted.Covers(tc.want))
+		})
+	}
+}
+
+func TestAnyCovers(t *testing.T) {
+	perms := []Permission{
+		{Action: PermissionActionPublish, ResourcePattern: "io.github.example/*"},
+	}
+	assert.True(t, AnyCovers(perms, Permission{Action: PermissionActionPublish, ResourcePattern: "io.github.example/foo"}))
+	assert.False(t, AnyCovers(perms, Permission{Action: PermissionActionPublish, ResourcePattern: "io.github.other/foo"}))
+}
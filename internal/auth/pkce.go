@@ -0,0 +1,24 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+)
+
+// VerifyPKCEChallenge checks that verifier, once transformed by method,
+// equals challenge (RFC 7636 §4.6). It only supports "S256"; "plain" is
+// deliberately not implemented, since it offers no protection against a
+// code interception attack, the exact threat PKCE exists to close.
+func VerifyPKCEChallenge(method, challenge, verifier string) error {
+	if method != "S256" {
+		return fmt.Errorf("%w: %q", ErrUnsupportedChallengeMethod, method)
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	computed := base64.RawURLEncoding.EncodeToString(sum[:])
+	if subtle.ConstantTimeCompare([]byte(computed), []byte(challenge)) != 1 {
+		return ErrPKCEVerifierMismatch
+	}
+	return nil
+}
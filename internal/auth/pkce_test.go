@@ -0,0 +1,23 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func challengeFor(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func TestVerifyPKCEChallenge(t *testing.T) {
+	verifier := "a-secret-code-verifier-at-least-43-chars-long"
+	challenge := challengeFor(verifier)
+
+	assert.NoError(t, VerifyPKCEChallenge("S256", challenge, verifier))
+	assert.ErrorIs(t, VerifyPKCEChallenge("S256", challenge, "wrong-verifier"), ErrPKCEVerifierMismatch)
+	assert.ErrorIs(t, VerifyPKCEChallenge("plain", challenge, verifier), ErrUnsupportedChallengeMethod)
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resourceTypeServer is the only resource type ParseScope currently
+// understands; it mirrors Docker Distribution v2's "repository" scope
+// type but for MCP server names instead of image repositories.
+const resourceTypeServer = "server"
+
+// ParseScope parses a Docker Registry v2-style scope string of the form
+// "server:<name-pattern>:<action>[,<action>]" into the Permissions it
+// grants, so a /v0/token request's requested scope can be turned
+// directly into the Permissions embedded in the issued JWTClaims.
+func ParseScope(scope string) ([]Permission, error) {
+	parts := strings.SplitN(scope, ":", 3)
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+	}
+	resourceType, pattern, actions := parts[0], parts[1], parts[2]
+
+	if resourceType != resourceTypeServer {
+		return nil, fmt.Errorf("%w: unsupported resource type %q", ErrInvalidScope, resourceType)
+	}
+	if pattern == "" {
+		return nil, fmt.Errorf("%w: empty resource pattern in %q", ErrInvalidScope, scope)
+	}
+
+	actionList := strings.Split(actions, ",")
+	perms := make([]Permission, 0, len(actionList))
+	for _, action := range actionList {
+		if action == "" {
+			return nil, fmt.Errorf("%w: empty action in %q", ErrInvalidScope, scope)
+		}
+		perms = append(perms, Permission{Action: PermissionAction(action), ResourcePattern: pattern})
+	}
+	return perms, nil
+}
+
+// Scope renders p back into the scope string grammar ParseScope
+// accepts, e.g. "server:io.github.example/*:publish".
+func (p Permission) Scope() string {
+	return fmt.Sprintf("%s:%s:%s", resourceTypeServer, p.ResourcePattern, p.Action)
+}
+
+// BearerChallenge renders a Docker Registry v2-style WWW-Authenticate
+// challenge: realm is the token service's URL, service identifies the
+// registry being authenticated to, and scope is the permission the
+// request was missing (see Permission.Scope). A client that receives
+// this on a 401 knows exactly which token to fetch from realm and with
+// which scope to ask for it.
+func BearerChallenge(realm, service, scope string) string {
+	return fmt.Sprintf("Bearer realm=%q,service=%q,scope=%q", realm, service, scope)
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScope(t *testing.T) {
+	perms, err := ParseScope("server:io.github.example/*:publish")
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{{Action: PermissionActionPublish, ResourcePattern: "io.github.example/*"}}, perms)
+}
+
+func TestParseScope_MultipleActions(t *testing.T) {
+	perms, err := ParseScope("server:io.github.example/*:publish,publish")
+	require.NoError(t, err)
+	assert.Len(t, perms, 2)
+}
+
+func TestParseScope_Invalid(t *testing.T) {
+	testCases := []string{
+		"server:io.github.example/*",             // missing action
+		"repository:io.github.example/*:publish", // wrong resource type
+		"server::publish",                        // empty pattern
+		"server:io.github.example/*:",            // empty action
+		"not-a-scope-at-all",
+	}
+	for _, scope := range testCases {
+		t.Run(scope, func(t *testing.T) {
+			_, err := ParseScope(scope)
+			assert.ErrorIs(t, err, ErrInvalidScope)
+		})
+	}
+}
+
+func TestPermission_Scope_RoundTrip(t *testing.T) {
+	p := Permission{Action: PermissionActionPublish, ResourcePattern: "io.github.example/*"}
+	perms, err := ParseScope(p.Scope())
+	require.NoError(t, err)
+	assert.Equal(t, []Permission{p}, perms)
+}
+
+func TestBearerChallenge(t *testing.T) {
+	header := BearerChallenge("https://registry.example.com/v0/token", "mcp-registry", "server:io.github.example/foo:publish")
+	assert.Equal(t, `Bearer realm="https://registry.example.com/v0/token",service="mcp-registry",scope="server:io.github.example/foo:publish"`, header)
+}
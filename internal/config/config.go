@@ -0,0 +1,73 @@
+// Package config holds process-wide configuration for the registry
+// service, populated from environment variables at startup.
+package config
+
+import (
+	"github.com/modelcontextprotocol/registry/internal/validators/packages"
+	"github.com/modelcontextprotocol/registry/internal/validators/snapshot"
+)
+
+// Registry validation modes for Config.RegistryValidationMode.
+const (
+	// RegistryValidationModeOnline checks package existence and digests
+	// by reaching out to the live upstream registry (npmjs.org, PyPI,
+	// GHCR, ...). It is used when RegistryValidationMode is left unset.
+	RegistryValidationModeOnline = "online"
+
+	// RegistryValidationModeOffline skips upstream existence/digest
+	// checks entirely, for environments with no network access at all.
+	RegistryValidationModeOffline = "offline"
+
+	// RegistryValidationModeSnapshot resolves existence/digest checks
+	// against SnapshotStore instead of the network, so a publish
+	// validates deterministically in air-gapped CI and hermetic build
+	// environments (the pattern Nix derivations and Bazel use).
+	RegistryValidationModeSnapshot = "snapshot"
+)
+
+// Config holds the registry's runtime configuration.
+type Config struct {
+	// JWTPrivateKey is the hex-encoded ed25519 seed used to sign registry
+	// tokens.
+	JWTPrivateKey string
+
+	// EnableRegistryValidation gates the network calls validators make to
+	// confirm that published packages actually exist upstream.
+	EnableRegistryValidation bool
+
+	// RegistryValidationMode selects how EnableRegistryValidation's
+	// checks are performed; see the RegistryValidationMode* constants.
+	// Defaults to RegistryValidationModeOnline.
+	RegistryValidationMode string
+
+	// SnapshotStore is consulted instead of the network when
+	// RegistryValidationMode is RegistryValidationModeSnapshot. It is
+	// ignored in the other modes.
+	SnapshotStore snapshot.Store
+
+	// EnableGenericGitRepositoryFallback lets publishers use a repository
+	// host that isn't one of the registry's known providers, as long as
+	// the URL is HTTPS and ends in an owner/repo-shaped path.
+	EnableGenericGitRepositoryFallback bool
+
+	// RequireNamespaceAttestations gates enforcement of
+	// provenance.ValidateAttestations's namespace identity policy (e.g.
+	// requiring a signed GitHub Actions attestation for io.github.*
+	// servers). It defaults to off so existing publishers aren't broken
+	// by a policy they haven't opted into; registries that mint
+	// namespaces under a verified CI convention should turn it on.
+	RequireNamespaceAttestations bool
+
+	// MaxArtifactSizeBytes caps the size of the artifact downloaded to
+	// verify a package's declared FileSHA256. Zero means
+	// packages.DefaultMaxArtifactSize. Ignored outside
+	// RegistryValidationModeOnline, where digests come from the
+	// snapshot bundle or aren't checked at all.
+	MaxArtifactSizeBytes int64
+
+	// ArtifactDigestCache, when set, lets repeated publishes/mirrors of
+	// an already-verified (registry type, identifier, version) skip
+	// re-downloading the artifact. Ignored outside
+	// RegistryValidationModeOnline.
+	ArtifactDigestCache packages.DigestCache
+}
@@ -0,0 +1,97 @@
+package registries_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/modelcontextprotocol/registry/internal/registries"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// conformanceCase is one identifier a built-in backend must accept or
+// reject, used to give every registered Backend uniform coverage without
+// re-deriving its naming rules by hand for each new ecosystem.
+type conformanceCase struct {
+	name        string
+	identifier  string
+	version     string
+	fileSHA256  string
+	expectError bool
+}
+
+func TestBackendConformance(t *testing.T) {
+	suites := map[string][]conformanceCase{
+		model.RegistryTypeNPM: {
+			{"unscoped name", "airtable-mcp-server", "1.7.2", "", false},
+			{"scoped name", "@modelcontextprotocol/server-filesystem", "2.1.0", "", false},
+			{"uppercase name is rejected", "Some-Package", "1.0.0", "", true},
+		},
+		model.RegistryTypePyPI: {
+			{"simple name", "time-mcp-pypi", "1.0.1", "", false},
+			{"name with invalid characters is rejected", "time mcp pypi", "1.0.1", "", true},
+		},
+		model.RegistryTypeOCI: {
+			{"namespaced reference", "domdomegg/airtable-mcp-server", "1.7.2", "", false},
+			{"uppercase component is rejected", "domdomegg/Airtable-MCP-Server", "1.7.2", "", true},
+		},
+		model.RegistryTypeNuGet: {
+			{"package id", "TimeMcpServer", "1.0.2", "", false},
+		},
+		model.RegistryTypeMCPB: {
+			{"github release download", "https://github.com/domdomegg/airtable-mcp-server/releases/download/v1.7.2/airtable-mcp-server.mcpb", "1.7.2", "fe333e598595000ae021bd27117db32ec69af6987f507ba7a63c90638ff633ce", false},
+			{"missing fileSha256 is rejected", "https://github.com/domdomegg/airtable-mcp-server/releases/download/v1.7.2/airtable-mcp-server.mcpb", "1.7.2", "", true},
+			{"unsupported host is rejected", "https://example.com/airtable-mcp-server.mcpb", "1.7.2", "abc123", true},
+			{"lookalike host is rejected", "https://evil-github.com/attacker/payload.mcpb", "1.7.2", "abc123", true},
+			{"github subdomain is accepted", "https://codeload.github.com/domdomegg/airtable-mcp-server/tar.gz/v1.7.2", "1.7.2", "abc123", false},
+		},
+	}
+
+	for registryType, cases := range suites {
+		t.Run(registryType, func(t *testing.T) {
+			backend, ok := registries.Lookup(registryType)
+			if !assert.True(t, ok, "no backend registered for %q", registryType) {
+				return
+			}
+			assert.Equal(t, registryType, backend.Type())
+
+			for _, tc := range cases {
+				t.Run(tc.name, func(t *testing.T) {
+					pkg := model.Package{
+						RegistryType: registryType,
+						Identifier:   tc.identifier,
+						Version:      tc.version,
+						FileSHA256:   tc.fileSHA256,
+					}
+					_, err := backend.ValidateIdentifier(pkg)
+					if tc.expectError {
+						assert.Error(t, err)
+					} else {
+						assert.NoError(t, err)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestBackendConformance_DefaultBaseURLMatchesRegisteredURLs(t *testing.T) {
+	expected := map[string]string{
+		model.RegistryTypeNPM:   model.RegistryURLNPM,
+		model.RegistryTypePyPI:  model.RegistryURLPyPI,
+		model.RegistryTypeOCI:   model.RegistryURLDocker,
+		model.RegistryTypeNuGet: model.RegistryURLNuGet,
+	}
+	for registryType, baseURL := range expected {
+		backend, ok := registries.Lookup(registryType)
+		if !assert.True(t, ok, "no backend registered for %q", registryType) {
+			continue
+		}
+		assert.Equal(t, baseURL, backend.DefaultBaseURL(), "registryType=%s", registryType)
+	}
+}
+
+func TestLookup_UnregisteredType(t *testing.T) {
+	_, ok := registries.Lookup("maven")
+	assert.False(t, ok, "maven should not have a built-in backend registered")
+}
@@ -0,0 +1,53 @@
+package registries
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/datasource"
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// mcpbBackend validates MCPB packages, which are keyed by a download URL
+// on a supported release host rather than a registry name/version pair.
+// Its identifier rules depend on RegistryBaseURL and FileSHA256 as well
+// as Identifier, so unlike the other built-ins it has no single
+// DefaultBaseURL to check generically.
+type mcpbBackend struct{}
+
+func (mcpbBackend) Type() string           { return model.RegistryTypeMCPB }
+func (mcpbBackend) DefaultBaseURL() string { return "" }
+
+func (mcpbBackend) ValidateIdentifier(pkg model.Package) (*pkgspec.Result, error) {
+	if pkg.FileSHA256 == "" {
+		return nil, fmt.Errorf("fileSha256 is required for mcpb packages")
+	}
+	u, err := url.Parse(pkg.Identifier)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("mcpb package identifier must be a download url, got %q", pkg.Identifier)
+	}
+	var expected string
+	switch {
+	case u.Host == "github.com" || strings.HasSuffix(u.Host, ".github.com"):
+		expected = model.RegistryURLGitHub
+	case u.Host == "gitlab.com" || strings.HasSuffix(u.Host, ".gitlab.com"):
+		expected = model.RegistryURLGitLab
+	default:
+		return nil, fmt.Errorf("mcpb package identifier host %q is not a supported release host", u.Host)
+	}
+	if pkg.RegistryBaseURL != "" && pkg.RegistryBaseURL != expected {
+		return nil, fmt.Errorf("registry base url %s does not match expected %s for mcpb identifier host", pkg.RegistryBaseURL, expected)
+	}
+	return nil, nil
+}
+
+func (mcpbBackend) VerifyArtifactExists(ctx context.Context, pkg model.Package) error {
+	return datasource.Verify(ctx, pkg)
+}
+
+func (mcpbBackend) ComputeExpectedSHA256(context.Context, model.Package) (string, error) {
+	return "", ErrSHA256ComputationUnsupported
+}
@@ -0,0 +1,26 @@
+package registries
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/datasource"
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type npmBackend struct{}
+
+func (npmBackend) Type() string           { return model.RegistryTypeNPM }
+func (npmBackend) DefaultBaseURL() string { return model.RegistryURLNPM }
+
+func (npmBackend) ValidateIdentifier(pkg model.Package) (*pkgspec.Result, error) {
+	return pkgspec.ValidateNPM(pkg.Identifier)
+}
+
+func (npmBackend) VerifyArtifactExists(ctx context.Context, pkg model.Package) error {
+	return datasource.Verify(ctx, pkg)
+}
+
+func (npmBackend) ComputeExpectedSHA256(context.Context, model.Package) (string, error) {
+	return "", ErrSHA256ComputationUnsupported
+}
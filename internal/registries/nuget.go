@@ -0,0 +1,26 @@
+package registries
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/datasource"
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type nugetBackend struct{}
+
+func (nugetBackend) Type() string           { return model.RegistryTypeNuGet }
+func (nugetBackend) DefaultBaseURL() string { return model.RegistryURLNuGet }
+
+func (nugetBackend) ValidateIdentifier(pkg model.Package) (*pkgspec.Result, error) {
+	return pkgspec.ValidateNuGet(pkg.Identifier)
+}
+
+func (nugetBackend) VerifyArtifactExists(ctx context.Context, pkg model.Package) error {
+	return datasource.Verify(ctx, pkg)
+}
+
+func (nugetBackend) ComputeExpectedSHA256(context.Context, model.Package) (string, error) {
+	return "", ErrSHA256ComputationUnsupported
+}
@@ -0,0 +1,26 @@
+package registries
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/datasource"
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type ociBackend struct{}
+
+func (ociBackend) Type() string           { return model.RegistryTypeOCI }
+func (ociBackend) DefaultBaseURL() string { return model.RegistryURLDocker }
+
+func (ociBackend) ValidateIdentifier(pkg model.Package) (*pkgspec.Result, error) {
+	return pkgspec.ValidateOCI(pkg.Identifier)
+}
+
+func (ociBackend) VerifyArtifactExists(ctx context.Context, pkg model.Package) error {
+	return datasource.Verify(ctx, pkg)
+}
+
+func (ociBackend) ComputeExpectedSHA256(context.Context, model.Package) (string, error) {
+	return "", ErrSHA256ComputationUnsupported
+}
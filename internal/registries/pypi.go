@@ -0,0 +1,26 @@
+package registries
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/datasource"
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type pypiBackend struct{}
+
+func (pypiBackend) Type() string           { return model.RegistryTypePyPI }
+func (pypiBackend) DefaultBaseURL() string { return model.RegistryURLPyPI }
+
+func (pypiBackend) ValidateIdentifier(pkg model.Package) (*pkgspec.Result, error) {
+	return pkgspec.ValidatePyPI(pkg.Identifier)
+}
+
+func (pypiBackend) VerifyArtifactExists(ctx context.Context, pkg model.Package) error {
+	return datasource.Verify(ctx, pkg)
+}
+
+func (pypiBackend) ComputeExpectedSHA256(context.Context, model.Package) (string, error) {
+	return "", ErrSHA256ComputationUnsupported
+}
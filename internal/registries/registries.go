@@ -0,0 +1,78 @@
+// Package registries lets third parties add support for new package
+// ecosystems without editing core validator code. Each Backend owns the
+// rules for one model.RegistryType*: its default base URL, how to
+// validate an identifier, and how to check a published artifact against
+// the upstream registry. This mirrors the plugin shape already used by
+// internal/validators/datasource and internal/validators/provenance.
+package registries
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// ErrSHA256ComputationUnsupported is returned by ComputeExpectedSHA256
+// implementations that can validate an identifier and check existence
+// but don't yet fetch the artifact itself to hash it.
+var ErrSHA256ComputationUnsupported = errors.New("computing the expected sha256 digest is not supported for this registry type")
+
+// Backend implements the validation and verification rules for one
+// package registry type.
+type Backend interface {
+	// Type returns the model.RegistryType* value this backend handles.
+	Type() string
+
+	// DefaultBaseURL returns the base URL a package of this type is
+	// expected to use when RegistryBaseURL is left blank, or "" if this
+	// registry type has no single default (its identifier determines
+	// the base URL instead, as for MCPB download hosts).
+	DefaultBaseURL() string
+
+	// ValidateIdentifier checks pkg.Identifier (and any other fields
+	// this registry type's naming rules depend on, such as
+	// FileSHA256 for MCPB) and returns its parsed form.
+	ValidateIdentifier(pkg model.Package) (*pkgspec.Result, error)
+
+	// VerifyArtifactExists checks that pkg's identifier/version
+	// actually exists in the upstream registry.
+	VerifyArtifactExists(ctx context.Context, pkg model.Package) error
+
+	// ComputeExpectedSHA256 returns the digest the upstream registry
+	// records for pkg, for comparison against FileSHA256.
+	ComputeExpectedSHA256(ctx context.Context, pkg model.Package) (string, error)
+}
+
+var (
+	mu       sync.RWMutex
+	backends = map[string]Backend{}
+)
+
+// Register adds or replaces the backend used for a registry type. Third
+// parties call this (via validators.RegisterBackend) to add an
+// ecosystem the built-ins don't cover, such as Maven, Cargo, RubyGems,
+// the Go module proxy, or Hex.
+func Register(backend Backend) {
+	mu.Lock()
+	defer mu.Unlock()
+	backends[backend.Type()] = backend
+}
+
+// Lookup returns the backend registered for a registry type.
+func Lookup(registryType string) (Backend, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	b, ok := backends[registryType]
+	return b, ok
+}
+
+func init() {
+	Register(&npmBackend{})
+	Register(&pypiBackend{})
+	Register(&ociBackend{})
+	Register(&nugetBackend{})
+	Register(&mcpbBackend{})
+}
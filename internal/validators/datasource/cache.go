@@ -0,0 +1,93 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// errCircuitOpen is returned internally when the circuit breaker has
+// tripped for a datasource; Verify treats it the same as "no datasource
+// registered" rather than as a verification failure.
+var errCircuitOpen = errors.New("datasource circuit open")
+
+const (
+	cacheTTL             = 5 * time.Minute
+	negativeCacheTTL     = 30 * time.Second
+	circuitOpenThreshold = 3
+	circuitResetTimeout  = 30 * time.Second
+)
+
+// cacheEntry holds a previous lookup result, including negative results
+// (a package genuinely not found), so a burst of publishes for the same
+// package doesn't refetch it from upstream every time.
+type cacheEntry struct {
+	releases []Release
+	err      error
+	expires  time.Time
+}
+
+// cachingDatasource wraps a Datasource with a TTL cache and a circuit
+// breaker, so a slow or failing upstream registry degrades to "skip
+// verification" instead of stalling or rejecting every publish.
+type cachingDatasource struct {
+	inner Datasource
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+
+	consecutiveFailures int
+	openUntil           time.Time
+}
+
+func withCache(inner Datasource) Datasource {
+	return &cachingDatasource{inner: inner, entries: map[string]cacheEntry{}}
+}
+
+func cacheKey(pkg model.Package) string {
+	return pkg.RegistryBaseURL + "|" + pkg.Identifier
+}
+
+func (d *cachingDatasource) GetReleases(ctx context.Context, pkg model.Package) ([]Release, error) {
+	key := cacheKey(pkg)
+
+	d.mu.Lock()
+	if entry, ok := d.entries[key]; ok && time.Now().Before(entry.expires) {
+		d.mu.Unlock()
+		return entry.releases, entry.err
+	}
+	circuitOpen := !d.openUntil.IsZero() && time.Now().Before(d.openUntil)
+	d.mu.Unlock()
+
+	if circuitOpen {
+		// Upstream has been failing repeatedly; skip verification rather
+		// than blocking publish on a registry outage.
+		return nil, errCircuitOpen
+	}
+
+	releases, err := d.inner.GetReleases(ctx, pkg)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if err != nil {
+		d.consecutiveFailures++
+		if d.consecutiveFailures >= circuitOpenThreshold {
+			d.openUntil = time.Now().Add(circuitResetTimeout)
+			d.consecutiveFailures = 0
+		}
+		// Don't cache transport errors: a one-off network blip shouldn't
+		// be remembered as a verdict about the package.
+		return nil, err
+	}
+	d.consecutiveFailures = 0
+
+	ttl := cacheTTL
+	if len(releases) == 0 {
+		ttl = negativeCacheTTL
+	}
+	d.entries[key] = cacheEntry{releases: releases, err: nil, expires: time.Now().Add(ttl)}
+	return releases, nil
+}
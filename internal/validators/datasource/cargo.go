@@ -0,0 +1,75 @@
+package datasource
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type cargoDatasource struct {
+	client *http.Client
+}
+
+// sparseIndexPath mirrors cargo's sparse registry index layout: crates
+// named 1 or 2 characters live directly under their length, 3-character
+// crates are split by their first character, and everything else is
+// split into two 2-character directories.
+func sparseIndexPath(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case len(lower) == 1:
+		return fmt.Sprintf("1/%s", lower)
+	case len(lower) == 2:
+		return fmt.Sprintf("2/%s", lower)
+	case len(lower) == 3:
+		return fmt.Sprintf("3/%s/%s", lower[:1], lower)
+	default:
+		return fmt.Sprintf("%s/%s/%s", lower[:2], lower[2:4], lower)
+	}
+}
+
+func (d *cargoDatasource) GetReleases(ctx context.Context, pkg model.Package) ([]Release, error) {
+	url := "https://index.crates.io/" + sparseIndexPath(pkg.Identifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d from crates.io index", resp.StatusCode)
+	}
+
+	var releases []Release
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var entry struct {
+			Vers   string `json:"vers"`
+			Yanked bool   `json:"yanked"`
+		}
+		if err := json.Unmarshal(line, &entry); err != nil {
+			continue
+		}
+		if entry.Yanked {
+			continue
+		}
+		releases = append(releases, Release{Version: entry.Vers})
+	}
+	return releases, scanner.Err()
+}
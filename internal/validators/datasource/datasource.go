@@ -0,0 +1,144 @@
+// Package datasource looks up whether a package identifier/version
+// actually exists in its upstream registry, following the same plugin
+// shape as Renovate's datasources: each registry type gets a
+// GetReleases implementation, and callers fan out across packages with
+// bounded concurrency, a short-lived cache, and a circuit breaker so a
+// flaky upstream can't block every publish.
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// Release is one published version of a package, as reported by its
+// upstream registry.
+type Release struct {
+	Version string
+}
+
+// Datasource looks up the releases of a package in one registry type.
+type Datasource interface {
+	GetReleases(ctx context.Context, pkg model.Package) ([]Release, error)
+}
+
+var (
+	mu          sync.RWMutex
+	datasources = map[string]Datasource{}
+)
+
+// Register adds or replaces the datasource used for a registry type, so
+// private registries can be supported without forking the validator.
+func Register(registryType string, ds Datasource) {
+	mu.Lock()
+	defer mu.Unlock()
+	datasources[registryType] = ds
+}
+
+// Lookup returns the datasource registered for a registry type.
+func Lookup(registryType string) (Datasource, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	ds, ok := datasources[registryType]
+	return ds, ok
+}
+
+func init() {
+	client := &http.Client{Timeout: 10 * time.Second}
+	Register(model.RegistryTypeNPM, withCache(&npmDatasource{client: client, baseURL: model.RegistryURLNPM}))
+	Register(model.RegistryTypePyPI, withCache(&pypiDatasource{client: client}))
+	Register(model.RegistryTypeNuGet, withCache(&nugetDatasource{client: client, baseURL: model.RegistryURLNuGet}))
+	Register("cargo", withCache(&cargoDatasource{client: client}))
+	Register(model.RegistryTypeOCI, withCache(&ociDatasource{client: client}))
+}
+
+// Verify confirms that pkg's identifier (and version, if set) exists in
+// its registry's datasource. It returns nil if no datasource is
+// registered for the registry type, since that's the pluggable
+// "unverifiable registry" case rather than a failure.
+func Verify(ctx context.Context, pkg model.Package) error {
+	ds, ok := Lookup(pkg.RegistryType)
+	if !ok {
+		return nil
+	}
+	releases, err := ds.GetReleases(ctx, pkg)
+	if errors.Is(err, errCircuitOpen) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(releases) == 0 {
+		return fmt.Errorf("%w: %s", verr.ErrPackageNotFoundUpstream, pkg.Identifier)
+	}
+	if pkg.Version == "" {
+		return nil
+	}
+	for _, r := range releases {
+		if r.Version == pkg.Version {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s@%s", verr.ErrPackageVersionNotFoundUpstream, pkg.Identifier, pkg.Version)
+}
+
+// VerifyAll fans Verify out across packages with bounded concurrency,
+// returning the first error encountered (if any). maxConcurrency<=0
+// defaults to 8.
+func VerifyAll(ctx context.Context, packages []model.Package, maxConcurrency int) error {
+	if maxConcurrency <= 0 {
+		maxConcurrency = 8
+	}
+	sem := make(chan struct{}, maxConcurrency)
+	errCh := make(chan error, len(packages))
+	var wg sync.WaitGroup
+
+	for _, pkg := range packages {
+		pkg := pkg
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errCh <- Verify(ctx, pkg)
+		}()
+	}
+	wg.Wait()
+	close(errCh)
+
+	for err := range errCh {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func httpGetJSON(ctx context.Context, client *http.Client, url string, v interface{}) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return resp.StatusCode, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, body)
+	}
+	return resp.StatusCode, json.NewDecoder(resp.Body).Decode(v)
+}
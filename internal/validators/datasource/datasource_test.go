@@ -0,0 +1,120 @@
+package datasource
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+func TestNpmDatasource_GetReleases(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"versions":{"1.0.0":{},"1.1.0":{}}}`))
+	}))
+	defer srv.Close()
+
+	ds := &npmDatasource{client: srv.Client(), baseURL: srv.URL}
+	releases, err := ds.GetReleases(context.Background(), model.Package{Identifier: "some-pkg"})
+	require.NoError(t, err)
+	assert.Len(t, releases, 2)
+}
+
+func TestNpmDatasource_NotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	ds := &npmDatasource{client: srv.Client(), baseURL: srv.URL}
+	releases, err := ds.GetReleases(context.Background(), model.Package{Identifier: "missing-pkg"})
+	require.NoError(t, err)
+	assert.Empty(t, releases)
+}
+
+func TestSparseIndexPath(t *testing.T) {
+	tests := map[string]string{
+		"a":          "1/a",
+		"ab":         "2/ab",
+		"abc":        "3/a/abc",
+		"abcd":       "ab/cd/abcd",
+		"serde_json": "se/rd/serde_json",
+	}
+	for name, want := range tests {
+		assert.Equal(t, want, sparseIndexPath(name), "name=%s", name)
+	}
+}
+
+func TestVerify_NoRegisteredDatasource(t *testing.T) {
+	err := Verify(context.Background(), model.Package{RegistryType: "not-a-real-registry"})
+	assert.NoError(t, err)
+}
+
+type stubDatasource struct {
+	releases []Release
+	err      error
+	calls    int
+}
+
+func (s *stubDatasource) GetReleases(_ context.Context, _ model.Package) ([]Release, error) {
+	s.calls++
+	return s.releases, s.err
+}
+
+func TestCachingDatasource_CachesPositiveAndNegativeResults(t *testing.T) {
+	stub := &stubDatasource{releases: []Release{{Version: "1.0.0"}}}
+	cached := withCache(stub)
+
+	pkg := model.Package{Identifier: "cached-pkg"}
+	_, err := cached.GetReleases(context.Background(), pkg)
+	require.NoError(t, err)
+	_, err = cached.GetReleases(context.Background(), pkg)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, stub.calls, "second call should be served from cache")
+
+	missing := &stubDatasource{}
+	cachedMissing := withCache(missing)
+
+	missingPkg := model.Package{Identifier: "missing-pkg"}
+	releases, err := cachedMissing.GetReleases(context.Background(), missingPkg)
+	require.NoError(t, err)
+	assert.Empty(t, releases)
+	_, err = cachedMissing.GetReleases(context.Background(), missingPkg)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, missing.calls, "a not-found result should also be served from cache")
+}
+
+func TestCachingDatasource_OpensCircuitAfterRepeatedFailures(t *testing.T) {
+	stub := &stubDatasource{err: errors.New("upstream unavailable")}
+	cached := withCache(stub)
+
+	pkg := model.Package{Identifier: "flaky-pkg"}
+	for i := 0; i < circuitOpenThreshold; i++ {
+		_, err := cached.GetReleases(context.Background(), pkg)
+		assert.Error(t, err)
+	}
+
+	_, err := cached.GetReleases(context.Background(), pkg)
+	assert.ErrorIs(t, err, errCircuitOpen)
+	assert.Equal(t, circuitOpenThreshold, stub.calls, "circuit should short-circuit further calls to the inner datasource")
+}
+
+func TestVerifyAll_BoundedConcurrency(t *testing.T) {
+	Register("stub-registry", &stubDatasource{releases: []Release{{Version: "1.0.0"}}})
+	defer Register("stub-registry", nil)
+
+	packages := make([]model.Package, 20)
+	for i := range packages {
+		packages[i] = model.Package{RegistryType: "stub-registry", Identifier: "pkg", Version: "1.0.0"}
+	}
+
+	err := VerifyAll(context.Background(), packages, 4)
+	assert.NoError(t, err)
+}
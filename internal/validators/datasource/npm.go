@@ -0,0 +1,40 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type npmDatasource struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (d *npmDatasource) GetReleases(ctx context.Context, pkg model.Package) ([]Release, error) {
+	baseURL := d.baseURL
+	if pkg.RegistryBaseURL != "" {
+		baseURL = pkg.RegistryBaseURL
+	}
+	url := strings.TrimSuffix(baseURL, "/") + "/" + strings.ReplaceAll(pkg.Identifier, "/", "%2F")
+
+	var body struct {
+		Versions map[string]json.RawMessage `json:"versions"`
+	}
+	status, err := httpGetJSON(ctx, d.client, url, &body)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+
+	releases := make([]Release, 0, len(body.Versions))
+	for version := range body.Versions {
+		releases = append(releases, Release{Version: version})
+	}
+	return releases, nil
+}
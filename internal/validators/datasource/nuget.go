@@ -0,0 +1,40 @@
+package datasource
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type nugetDatasource struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (d *nugetDatasource) GetReleases(ctx context.Context, pkg model.Package) ([]Release, error) {
+	baseURL := d.baseURL
+	if pkg.RegistryBaseURL != "" {
+		baseURL = pkg.RegistryBaseURL
+	}
+	id := strings.ToLower(pkg.Identifier)
+	url := strings.TrimSuffix(baseURL, "/") + "/v3-flatcontainer/" + id + "/index.json"
+
+	var body struct {
+		Versions []string `json:"versions"`
+	}
+	status, err := httpGetJSON(ctx, d.client, url, &body)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+
+	releases := make([]Release, 0, len(body.Versions))
+	for _, version := range body.Versions {
+		releases = append(releases, Release{Version: version})
+	}
+	return releases, nil
+}
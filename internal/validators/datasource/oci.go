@@ -0,0 +1,58 @@
+package datasource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type ociDatasource struct {
+	client *http.Client
+}
+
+// ociRegistryHost maps a package's declared registry base URL to the host
+// that actually serves the Registry v2 API, since Docker Hub's API lives
+// on a different host than the docker.io name it's addressed by.
+func ociRegistryHost(baseURL string) string {
+	if baseURL == "" || baseURL == model.RegistryURLDocker {
+		return "registry-1.docker.io"
+	}
+	return strings.TrimPrefix(strings.TrimPrefix(baseURL, "https://"), "http://")
+}
+
+func (d *ociDatasource) GetReleases(ctx context.Context, pkg model.Package) ([]Release, error) {
+	if pkg.Version == "" {
+		// Without a tag there's nothing to HEAD; treat the identifier as
+		// existing and let schema validation catch a missing version.
+		return []Release{{Version: ""}}, nil
+	}
+
+	host := ociRegistryHost(pkg.RegistryBaseURL)
+	url := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, pkg.Identifier, pkg.Version)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.oci.image.manifest.v1+json, application/vnd.docker.distribution.manifest.v2+json")
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return []Release{{Version: pkg.Version}}, nil
+	case http.StatusNotFound, http.StatusUnauthorized, http.StatusForbidden:
+		// Private or nonexistent: treat as "not found" rather than erroring
+		// the whole publish on a registry that requires auth to probe.
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unexpected status %d from oci registry", resp.StatusCode)
+	}
+}
@@ -0,0 +1,36 @@
+package datasource
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+type pypiDatasource struct {
+	client *http.Client
+}
+
+func (d *pypiDatasource) GetReleases(ctx context.Context, pkg model.Package) ([]Release, error) {
+	name := pkgspec.NormalizePyPI(pkg.Identifier)
+	url := "https://pypi.org/pypi/" + name + "/json"
+
+	var body struct {
+		Releases map[string]json.RawMessage `json:"releases"`
+	}
+	status, err := httpGetJSON(ctx, d.client, url, &body)
+	if err != nil {
+		return nil, err
+	}
+	if status == http.StatusNotFound {
+		return nil, nil
+	}
+
+	releases := make([]Release, 0, len(body.Releases))
+	for version := range body.Releases {
+		releases = append(releases, Release{Version: version})
+	}
+	return releases, nil
+}
@@ -0,0 +1,150 @@
+// Package diag holds the structured diagnostic types shared by the
+// validators package and its per-schema-version sub-packages, following
+// the same leaf-package shape as verr: schema version packages
+// (internal/validators/schema/...) build diag.Report values directly,
+// and the top-level validators package re-exports the types, without an
+// import cycle between the two.
+package diag
+
+import (
+	"errors"
+	"strings"
+)
+
+// Severity classifies how serious a Diagnostic is. Only SeverityError
+// diagnostics should cause a publish to be rejected; SeverityWarning and
+// SeverityInfo are informational and never block one.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityInfo    Severity = "info"
+)
+
+// Diagnostic is one machine-readable validation finding, precise enough
+// for editor/IDE tooling to underline the offending JSON location.
+type Diagnostic struct {
+	// Code is a stable, dotted identifier for the kind of finding, e.g.
+	// "version.range" or "repository.url". Callers should match on Code
+	// rather than Message, which is meant for humans.
+	Code string `json:"code"`
+
+	Severity Severity `json:"severity"`
+
+	// Path locates the offending value within the submitted document, as
+	// an RFC 6901 JSON Pointer, e.g. "/packages/0/version".
+	Path string `json:"path"`
+
+	Message string `json:"message"`
+
+	// Hint is an optional suggestion for how to fix the problem.
+	Hint string `json:"hint,omitempty"`
+
+	// Details carries structured, code-specific context beyond what fits
+	// in Message, e.g. {"expected": "sha256:...", "got": "sha256:..."}
+	// for a digest mismatch, so tooling can act on a finding without
+	// parsing it back out of the human-readable message.
+	Details map[string]any `json:"details,omitempty"`
+
+	// cause is the underlying error this diagnostic was built from, kept
+	// so Report.Err can still satisfy errors.Is for the original sentinel
+	// (e.g. verr.ErrVersionLooksLikeRange) without forcing every caller
+	// to switch on Code. It is never serialized.
+	cause error
+}
+
+// NewError builds an error-severity diagnostic from an existing error,
+// preserving it as the diagnostic's cause so errors.Is still works
+// against the original sentinel.
+func NewError(code, path string, err error) Diagnostic {
+	return Diagnostic{Code: code, Severity: SeverityError, Path: path, Message: err.Error(), cause: err}
+}
+
+// NewWarning builds a warning-severity diagnostic, such as a deprecated
+// field notice surfaced while migrating a document to a newer schema.
+func NewWarning(code, path, message string) Diagnostic {
+	return Diagnostic{Code: code, Severity: SeverityWarning, Path: path, Message: message}
+}
+
+// NewInfo builds an info-severity diagnostic, such as "could not reach
+// registry" from an upstream existence check that's advisory rather than
+// fatal.
+func NewInfo(code, path, message string) Diagnostic {
+	return Diagnostic{Code: code, Severity: SeverityInfo, Path: path, Message: message}
+}
+
+// WithDetails attaches structured details to a diagnostic, returning the
+// modified copy so it can be chained onto a New* constructor call.
+func (d Diagnostic) WithDetails(details map[string]any) Diagnostic {
+	d.Details = details
+	return d
+}
+
+// Report is the full set of diagnostics produced by validating or
+// migrating a ServerJSON document. A Report with no SeverityError
+// diagnostics represents an accepted document, possibly with warnings.
+type Report struct {
+	Diagnostics []Diagnostic `json:"diagnostics,omitempty"`
+}
+
+// Add appends a diagnostic to the report.
+func (r *Report) Add(d Diagnostic) {
+	r.Diagnostics = append(r.Diagnostics, d)
+}
+
+// HasErrors reports whether any diagnostic in the report is an error
+// that should block the publish.
+func (r Report) HasErrors() bool {
+	for _, d := range r.Diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the first error-severity diagnostic's cause as a plain
+// error, for callers that only need pass/fail. It returns nil if the
+// report has no errors, and falls back to the diagnostic's Message if no
+// cause was recorded.
+func (r Report) Err() error {
+	for _, d := range r.Diagnostics {
+		if d.Severity != SeverityError {
+			continue
+		}
+		if d.cause != nil {
+			return d.cause
+		}
+		return errors.New(d.Message)
+	}
+	return nil
+}
+
+// Error implements the error interface over every error-severity
+// diagnostic, so a Report can be returned directly wherever a plain
+// error was returned before (ValidateServerJSON, ValidatePublishRequest),
+// while tooling that wants Code/Path/Details for each problem can still
+// recover the full Report with a type assertion.
+func (r Report) Error() string {
+	var msgs []string
+	for _, d := range r.Diagnostics {
+		if d.Severity == SeverityError {
+			msgs = append(msgs, d.Message)
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap returns the first error-severity diagnostic's cause, so
+// errors.Is/errors.As still match the original sentinel (e.g.
+// verr.ErrPurlRegistryTypeMismatch) when a Report is returned in place of
+// a plain error.
+func (r Report) Unwrap() error {
+	for _, d := range r.Diagnostics {
+		if d.Severity == SeverityError && d.cause != nil {
+			return d.cause
+		}
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package diag
+
+// ProblemDetails is an RFC 9457 (application/problem+json) body carrying
+// the full diagnostic array for a rejected publish, so clients and
+// editor/IDE tooling get every problem (with its Path) in one response
+// instead of a single error string.
+type ProblemDetails struct {
+	Type        string       `json:"type"`
+	Title       string       `json:"title"`
+	Status      int          `json:"status"`
+	Detail      string       `json:"detail,omitempty"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// ProblemDetailsType is the problem "type" URI used for validation
+// failures returned from /v0/publish.
+const ProblemDetailsType = "https://modelcontextprotocol.io/problems/validation-failed"
+
+// ToProblemDetails renders a Report as a 422 application/problem+json
+// body. It includes every diagnostic in the report, not just errors, so
+// clients can also surface warnings (e.g. deprecated fields) alongside
+// the reasons the publish was rejected.
+func (r Report) ToProblemDetails() ProblemDetails {
+	return ProblemDetails{
+		Type:        ProblemDetailsType,
+		Title:       "Validation failed",
+		Status:      422,
+		Detail:      "The submitted server.json failed validation; see diagnostics for details.",
+		Diagnostics: r.Diagnostics,
+	}
+}
@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"github.com/modelcontextprotocol/registry/internal/validators/snapshot"
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+)
+
+// Sentinel errors returned by ValidateServerJSON and ValidatePublishRequest.
+// Callers that need to distinguish failure reasons should use errors.Is
+// rather than matching on message text. These are aliases onto verr so
+// that schema-version packages (which validators itself depends on) can
+// return the same values without an import cycle.
+var (
+	ErrVersionLooksLikeRange       = verr.ErrVersionLooksLikeRange
+	ErrMultipleSlashesInServerName = verr.ErrMultipleSlashesInServerName
+	ErrInvalidRepositoryURL        = verr.ErrInvalidRepositoryURL
+	ErrInvalidSubfolderPath        = verr.ErrInvalidSubfolderPath
+	ErrPackageNameHasSpaces        = verr.ErrPackageNameHasSpaces
+	ErrReservedVersionString       = verr.ErrReservedVersionString
+	ErrInvalidRemoteURL            = verr.ErrInvalidRemoteURL
+	ErrUnsupportedSchemaVersion    = verr.ErrUnsupportedSchemaVersion
+
+	ErrPackageNotFoundUpstream        = verr.ErrPackageNotFoundUpstream
+	ErrPackageVersionNotFoundUpstream = verr.ErrPackageVersionNotFoundUpstream
+
+	ErrInvalidPurl              = verr.ErrInvalidPurl
+	ErrPurlRegistryTypeMismatch = verr.ErrPurlRegistryTypeMismatch
+
+	ErrProvenanceSubjectDigestMismatch = verr.ErrProvenanceSubjectDigestMismatch
+	ErrProvenanceSourceMismatch        = verr.ErrProvenanceSourceMismatch
+	ErrUntrustedBuilderIdentity        = verr.ErrUntrustedBuilderIdentity
+	ErrTransparencyLogEntryNotFound    = verr.ErrTransparencyLogEntryNotFound
+
+	ErrTransportSchemeMismatch = verr.ErrTransportSchemeMismatch
+	ErrInvalidSubprotocol      = verr.ErrInvalidSubprotocol
+
+	ErrAttestationRequired         = verr.ErrAttestationRequired
+	ErrAttestationIdentityMismatch = verr.ErrAttestationIdentityMismatch
+
+	ErrInvalidNamedArgumentName = verr.ErrInvalidNamedArgumentName
+	ErrRedundantArgumentValue   = verr.ErrRedundantArgumentValue
+
+	ErrSnapshotEntryNotCaptured = snapshot.ErrNotCaptured
+)
@@ -0,0 +1,55 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+func TestMigrateToLatest(t *testing.T) {
+	t.Run("document without a schema version defaults to the latest", func(t *testing.T) {
+		raw := []byte(`{"name":"com.example/test-server","description":"A test server","version":"1.0.0"}`)
+
+		migrated, report, err := validators.MigrateToLatest(raw)
+		require.NoError(t, err)
+		assert.Equal(t, validators.LatestSchemaVersion, migrated.SchemaVersion)
+		assert.Empty(t, report.Diagnostics)
+	})
+
+	t.Run("document pinned to the current schema version round-trips", func(t *testing.T) {
+		raw := []byte(`{"$schemaVersion":"v0.1","name":"com.example/test-server","description":"A test server","version":"1.0.0"}`)
+
+		migrated, _, err := validators.MigrateToLatest(raw)
+		require.NoError(t, err)
+		assert.Equal(t, "com.example/test-server", migrated.Name)
+		assert.Equal(t, "v0.1", migrated.SchemaVersion)
+	})
+
+	t.Run("unknown schema version is rejected", func(t *testing.T) {
+		raw := []byte(`{"$schemaVersion":"v99.0","name":"com.example/test-server"}`)
+
+		_, _, err := validators.MigrateToLatest(raw)
+		assert.ErrorIs(t, err, validators.ErrUnsupportedSchemaVersion)
+	})
+
+	t.Run("malformed json is rejected", func(t *testing.T) {
+		_, _, err := validators.MigrateToLatest([]byte(`not json`))
+		assert.Error(t, err)
+	})
+}
+
+func TestValidateServerJSON_UnsupportedSchemaVersion(t *testing.T) {
+	server := apiv0.ServerJSON{
+		SchemaVersion: "v99.0",
+		Name:          "com.example/test-server",
+		Description:   "A test server",
+		Version:       "1.0.0",
+	}
+
+	err := validators.ValidateServerJSON(&server)
+	assert.ErrorIs(t, err, validators.ErrUnsupportedSchemaVersion)
+}
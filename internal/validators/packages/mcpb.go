@@ -0,0 +1,16 @@
+package packages
+
+import (
+	"context"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// mcpbVerifier is the identity case: an MCPB package's Identifier is
+// already validated (by registries.mcpbBackend) to be the download URL
+// of the .mcpb bundle itself.
+type mcpbVerifier struct{}
+
+func (mcpbVerifier) ArtifactURL(_ context.Context, pkg model.Package) (string, error) {
+	return pkg.Identifier, nil
+}
@@ -0,0 +1,53 @@
+package packages
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// npmVerifier resolves an npm package to its tarball URL via the
+// registry's packument metadata, the same endpoint datasource's npm
+// backend uses to list releases.
+type npmVerifier struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (v *npmVerifier) ArtifactURL(ctx context.Context, pkg model.Package) (string, error) {
+	baseURL := v.baseURL
+	if pkg.RegistryBaseURL != "" {
+		baseURL = pkg.RegistryBaseURL
+	}
+	url := strings.TrimSuffix(baseURL, "/") + "/" + strings.ReplaceAll(pkg.Identifier, "/", "%2F") + "/" + pkg.Version
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("packages: fetch npm metadata %s: unexpected status %d", url, resp.StatusCode)
+	}
+
+	var body struct {
+		Dist struct {
+			Tarball string `json:"tarball"`
+		} `json:"dist"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("packages: decode npm metadata %s: %w", url, err)
+	}
+	if body.Dist.Tarball == "" {
+		return "", fmt.Errorf("packages: npm metadata %s has no dist.tarball", url)
+	}
+	return body.Dist.Tarball, nil
+}
@@ -0,0 +1,26 @@
+package packages
+
+import (
+	"context"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// nugetVerifier resolves a NuGet package to its .nupkg URL under the
+// registry's v3 flat container resource, the same convention `dotnet
+// add package`/NuGet.Client use to fetch packages directly without
+// going through the search/registration APIs.
+type nugetVerifier struct {
+	baseURL string
+}
+
+func (v *nugetVerifier) ArtifactURL(_ context.Context, pkg model.Package) (string, error) {
+	baseURL := v.baseURL
+	if pkg.RegistryBaseURL != "" {
+		baseURL = pkg.RegistryBaseURL
+	}
+	id := strings.ToLower(pkg.Identifier)
+	version := strings.ToLower(pkg.Version)
+	return strings.TrimSuffix(baseURL, "/") + "/v3-flatcontainer/" + id + "/" + version + "/" + id + "." + version + ".nupkg", nil
+}
@@ -0,0 +1,203 @@
+// Package packages downloads the artifact a published Package identifier
+// resolves to and verifies its SHA256 digest and size against the
+// Package's declared FileSHA256, rather than trusting whatever the
+// datasource's registry metadata claims. It follows the same pluggable,
+// RegistryType-keyed shape as datasource and registries: built-in
+// Verifiers cover npm, nuget and mcpb; RegistryTypes without a
+// registered Verifier, or whose artifact isn't a single file this
+// package knows how to resolve a URL for (e.g. oci, whose artifact is a
+// multi-layer image rather than one blob), are treated as unverifiable
+// rather than a failure.
+package packages
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// DefaultMaxArtifactSize is the size cap VerifyArtifact enforces when
+// Options.MaxArtifactSize is left at zero.
+const DefaultMaxArtifactSize = 512 * 1024 * 1024 // 512 MiB
+
+var (
+	// ErrArtifactURLUnsupported is returned by a Verifier when it has no
+	// way to resolve pkg to a single downloadable artifact URL.
+	// VerifyArtifact treats it the same as no Verifier being registered
+	// at all: an unverifiable registry, not a failure.
+	ErrArtifactURLUnsupported = errors.New("packages: cannot resolve a single artifact URL for this registry type")
+
+	// ErrDigestMismatch is returned when the downloaded artifact's SHA256
+	// doesn't match Package.FileSHA256.
+	ErrDigestMismatch = errors.New("packages: digest mismatch")
+
+	// ErrArtifactTooLarge is returned when the artifact exceeds the
+	// configured size cap, whether reported by Content-Length or
+	// discovered while streaming the body.
+	ErrArtifactTooLarge = errors.New("packages: artifact exceeds maximum size")
+)
+
+// Verifier resolves a package to the URL of its installable artifact, so
+// VerifyArtifact can download and hash it. Implementations may need to
+// make network calls of their own (e.g. npm's registry metadata) to
+// resolve the exact URL, hence the context.
+type Verifier interface {
+	ArtifactURL(ctx context.Context, pkg model.Package) (string, error)
+}
+
+var (
+	mu        sync.RWMutex
+	verifiers = map[string]Verifier{}
+)
+
+// Register adds or replaces the Verifier used for a registry type.
+func Register(registryType string, v Verifier) {
+	mu.Lock()
+	defer mu.Unlock()
+	verifiers[registryType] = v
+}
+
+// Lookup returns the Verifier registered for a registry type.
+func Lookup(registryType string) (Verifier, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	v, ok := verifiers[registryType]
+	return v, ok
+}
+
+func init() {
+	client := &http.Client{Timeout: 2 * time.Minute}
+	Register(model.RegistryTypeNPM, &npmVerifier{client: client, baseURL: model.RegistryURLNPM})
+	Register(model.RegistryTypeNuGet, &nugetVerifier{baseURL: model.RegistryURLNuGet})
+	Register(model.RegistryTypeMCPB, mcpbVerifier{})
+}
+
+// DigestCache caches a previously verified artifact digest keyed by
+// (registryType, identifier, version), so a re-publish or registry
+// mirror of the exact same version doesn't re-download and re-hash an
+// artifact VerifyArtifact already confirmed. A nil cache (the default)
+// disables caching; the production implementation is expected to be
+// backed by the registry's database.
+type DigestCache interface {
+	Get(ctx context.Context, registryType, identifier, version string) (digest string, ok bool, err error)
+	Put(ctx context.Context, registryType, identifier, version, digest string) error
+}
+
+// Options configures VerifyArtifact.
+type Options struct {
+	// MaxArtifactSize caps the artifact's size in bytes. Zero means
+	// DefaultMaxArtifactSize.
+	MaxArtifactSize int64
+
+	// Cache, when non-nil, is consulted before downloading and updated
+	// after a successful verification.
+	Cache DigestCache
+
+	// Client is the HTTP client used to fetch artifacts. Defaults to a
+	// client with a timeout generous enough for a large tarball.
+	Client *http.Client
+}
+
+var defaultClient = &http.Client{Timeout: 2 * time.Minute}
+
+// VerifyArtifact downloads the artifact pkg resolves to and confirms its
+// SHA256 digest equals pkg.FileSHA256 and its size doesn't exceed
+// opts.MaxArtifactSize. It returns nil without downloading anything if
+// pkg.FileSHA256 is empty (nothing declared to verify against) or no
+// Verifier is registered for pkg.RegistryType, or that Verifier reports
+// ErrArtifactURLUnsupported (both are an unverifiable registry, not a
+// failure).
+func VerifyArtifact(ctx context.Context, pkg model.Package, opts Options) error {
+	if pkg.FileSHA256 == "" {
+		return nil
+	}
+	verifier, ok := Lookup(pkg.RegistryType)
+	if !ok {
+		return nil
+	}
+
+	if opts.Cache != nil {
+		cached, found, err := opts.Cache.Get(ctx, pkg.RegistryType, pkg.Identifier, pkg.Version)
+		if err == nil && found {
+			return compareDigest(cached, pkg.FileSHA256)
+		}
+	}
+
+	url, err := verifier.ArtifactURL(ctx, pkg)
+	if err != nil {
+		if errors.Is(err, ErrArtifactURLUnsupported) {
+			return nil
+		}
+		return err
+	}
+
+	maxSize := opts.MaxArtifactSize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxArtifactSize
+	}
+	client := opts.Client
+	if client == nil {
+		client = defaultClient
+	}
+
+	digest, err := hashArtifact(ctx, client, url, maxSize)
+	if err != nil {
+		return err
+	}
+	if err := compareDigest(digest, pkg.FileSHA256); err != nil {
+		return err
+	}
+
+	if opts.Cache != nil {
+		_ = opts.Cache.Put(ctx, pkg.RegistryType, pkg.Identifier, pkg.Version, digest)
+	}
+	return nil
+}
+
+func compareDigest(got, want string) error {
+	if got != want {
+		return fmt.Errorf("%w: expected sha256:%s, got sha256:%s", ErrDigestMismatch, want, got)
+	}
+	return nil
+}
+
+// hashArtifact streams url's body through sha256, rejecting a
+// Content-Length already over maxSize before reading any body bytes, and
+// re-checking as it streams in case Content-Length was absent or wrong.
+// The client is expected to follow redirects, as http.DefaultClient does.
+func hashArtifact(ctx context.Context, client *http.Client, url string, maxSize int64) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("packages: fetch %s: unexpected status %d", url, resp.StatusCode)
+	}
+	if resp.ContentLength > maxSize {
+		return "", fmt.Errorf("%w: %d bytes (limit %d)", ErrArtifactTooLarge, resp.ContentLength, maxSize)
+	}
+
+	h := sha256.New()
+	n, err := io.Copy(h, io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("packages: read %s: %w", url, err)
+	}
+	if n > maxSize {
+		return "", fmt.Errorf("%w: exceeds %d bytes", ErrArtifactTooLarge, maxSize)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
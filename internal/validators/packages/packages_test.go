@@ -0,0 +1,171 @@
+package packages
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func npmServer(t *testing.T, tarball []byte) *httptest.Server {
+	t.Helper()
+	var mux http.ServeMux
+	var srv *httptest.Server
+	mux.HandleFunc("/some-pkg/1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		// Real npm registries always return an absolute dist.tarball URL,
+		// so the fixture does too; ArtifactURL treats it as one directly.
+		_, _ = fmt.Fprintf(w, `{"dist":{"tarball":%q}}`, srv.URL+"/some-pkg/-/some-pkg-1.0.0.tgz")
+	})
+	mux.HandleFunc("/some-pkg/-/some-pkg-1.0.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarball)
+	})
+	srv = httptest.NewServer(&mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestVerifyArtifact_NPM_Success(t *testing.T) {
+	tarball := []byte("fake tarball contents")
+	srv := npmServer(t, tarball)
+
+	pkg := model.Package{
+		RegistryType:    model.RegistryTypeNPM,
+		RegistryBaseURL: srv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		FileSHA256:      sha256Hex(tarball),
+	}
+	err := VerifyArtifact(context.Background(), pkg, Options{Client: srv.Client()})
+	assert.NoError(t, err)
+}
+
+func TestVerifyArtifact_NPM_DigestMismatch(t *testing.T) {
+	srv := npmServer(t, []byte("fake tarball contents"))
+
+	pkg := model.Package{
+		RegistryType:    model.RegistryTypeNPM,
+		RegistryBaseURL: srv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		FileSHA256:      "0000000000000000000000000000000000000000000000000000000000000",
+	}
+	err := VerifyArtifact(context.Background(), pkg, Options{Client: srv.Client()})
+	assert.ErrorIs(t, err, ErrDigestMismatch)
+}
+
+func TestVerifyArtifact_TooLarge(t *testing.T) {
+	tarball := []byte("0123456789")
+	srv := npmServer(t, tarball)
+
+	pkg := model.Package{
+		RegistryType:    model.RegistryTypeNPM,
+		RegistryBaseURL: srv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		FileSHA256:      sha256Hex(tarball),
+	}
+	err := VerifyArtifact(context.Background(), pkg, Options{Client: srv.Client(), MaxArtifactSize: 5})
+	assert.ErrorIs(t, err, ErrArtifactTooLarge)
+}
+
+func TestVerifyArtifact_NPM_UpstreamUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	pkg := model.Package{
+		RegistryType:    model.RegistryTypeNPM,
+		RegistryBaseURL: srv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		FileSHA256:      "abc123",
+	}
+	err := VerifyArtifact(context.Background(), pkg, Options{Client: srv.Client()})
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrDigestMismatch)
+}
+
+func TestVerifyArtifact_EmptyFileSHA256IsNoOp(t *testing.T) {
+	pkg := model.Package{RegistryType: model.RegistryTypeNPM, Identifier: "some-pkg", Version: "1.0.0"}
+	err := VerifyArtifact(context.Background(), pkg, Options{})
+	assert.NoError(t, err)
+}
+
+func TestVerifyArtifact_UnregisteredRegistryTypeIsNoOp(t *testing.T) {
+	pkg := model.Package{RegistryType: "not-a-real-registry", Identifier: "some-pkg", FileSHA256: "abc123"}
+	err := VerifyArtifact(context.Background(), pkg, Options{})
+	assert.NoError(t, err)
+}
+
+func TestVerifyArtifact_MCPB_Success(t *testing.T) {
+	bundle := []byte("fake mcpb bundle")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(bundle)
+	}))
+	defer srv.Close()
+
+	pkg := model.Package{
+		RegistryType: model.RegistryTypeMCPB,
+		Identifier:   srv.URL + "/bundle.mcpb",
+		FileSHA256:   sha256Hex(bundle),
+	}
+	err := VerifyArtifact(context.Background(), pkg, Options{Client: srv.Client()})
+	assert.NoError(t, err)
+}
+
+type stubCache struct {
+	digest string
+	found  bool
+	puts   int
+}
+
+func (s *stubCache) Get(_ context.Context, _, _, _ string) (string, bool, error) {
+	return s.digest, s.found, nil
+}
+
+func (s *stubCache) Put(_ context.Context, _, _, _, digest string) error {
+	s.digest = digest
+	s.found = true
+	s.puts++
+	return nil
+}
+
+func TestVerifyArtifact_CacheHitSkipsDownload(t *testing.T) {
+	cache := &stubCache{digest: "cached-digest", found: true}
+	pkg := model.Package{RegistryType: model.RegistryTypeNPM, Identifier: "some-pkg", Version: "1.0.0", FileSHA256: "cached-digest"}
+
+	err := VerifyArtifact(context.Background(), pkg, Options{Cache: cache})
+	require.NoError(t, err)
+}
+
+func TestVerifyArtifact_CachesOnSuccess(t *testing.T) {
+	tarball := []byte("fake tarball contents")
+	srv := npmServer(t, tarball)
+	cache := &stubCache{}
+
+	pkg := model.Package{
+		RegistryType:    model.RegistryTypeNPM,
+		RegistryBaseURL: srv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		FileSHA256:      sha256Hex(tarball),
+	}
+	err := VerifyArtifact(context.Background(), pkg, Options{Client: srv.Client(), Cache: cache})
+	require.NoError(t, err)
+	assert.Equal(t, 1, cache.puts)
+	assert.Equal(t, sha256Hex(tarball), cache.digest)
+}
@@ -0,0 +1,242 @@
+// Package pkgspec parses and validates Package.Identifier values,
+// following the approach of npm-package-arg: an identifier is first
+// parsed into a typed shape (scoped/unscoped name, git URL, tarball URL,
+// alias, or local path), and then checked against the naming rules of
+// its declared registry type.
+package pkgspec
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Kind is the shape an identifier was parsed as.
+type Kind string
+
+const (
+	KindUnscopedName Kind = "unscoped-name"
+	KindScopedName   Kind = "scoped-name"
+	KindGitURL       Kind = "git-url"
+	KindTarballURL   Kind = "tarball-url"
+	KindAlias        Kind = "alias"
+	KindLocalPath    Kind = "local-path"
+)
+
+// Result is the parsed form of a Package.Identifier, stashed on the
+// package so downstream code (registry backends, datasource lookups)
+// doesn't have to re-parse it.
+type Result struct {
+	Raw   string
+	Kind  Kind
+	Scope string // npm scope, without the leading "@"
+	Name  string
+
+	// AliasOf/AliasVersion are set when Kind == KindAlias, e.g. for
+	// "npm:foo@1" aliases.
+	AliasOf      string
+	AliasVersion string
+}
+
+// Error is a structured validation failure for a package identifier,
+// carrying which registry rejected it and why.
+type Error struct {
+	Registry string
+	Reason   string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("invalid %s package identifier: %s", e.Registry, e.Reason)
+}
+
+var (
+	scopedNamePattern = regexp.MustCompile(`^@([^/]+)/(.+)$`)
+	aliasPattern      = regexp.MustCompile(`^npm:(.+)@(.+)$`)
+)
+
+// Parse classifies identifier into its shape without applying any
+// registry-specific naming rules.
+func Parse(identifier string) (*Result, error) {
+	switch {
+	case strings.HasPrefix(identifier, "git+"), strings.HasPrefix(identifier, "git://"),
+		strings.HasPrefix(identifier, "git@"):
+		return &Result{Raw: identifier, Kind: KindGitURL}, nil
+	case strings.HasPrefix(identifier, "https://"), strings.HasPrefix(identifier, "http://"):
+		return &Result{Raw: identifier, Kind: KindTarballURL}, nil
+	case strings.HasPrefix(identifier, "./"), strings.HasPrefix(identifier, "../"),
+		strings.HasPrefix(identifier, "/"), strings.HasPrefix(identifier, "file:"):
+		return &Result{Raw: identifier, Kind: KindLocalPath}, nil
+	}
+
+	if m := aliasPattern.FindStringSubmatch(identifier); m != nil {
+		return &Result{Raw: identifier, Kind: KindAlias, AliasOf: m[1], AliasVersion: m[2]}, nil
+	}
+
+	if m := scopedNamePattern.FindStringSubmatch(identifier); m != nil {
+		return &Result{Raw: identifier, Kind: KindScopedName, Scope: m[1], Name: m[2]}, nil
+	}
+
+	return &Result{Raw: identifier, Kind: KindUnscopedName, Name: identifier}, nil
+}
+
+// ValidateNPM enforces npm's published name rules: <=214 chars,
+// lowercase, URL-safe, optional "@scope/" prefix, no leading dot or
+// underscore.
+func ValidateNPM(identifier string) (*Result, error) {
+	r, err := Parse(identifier)
+	if err != nil {
+		return nil, err
+	}
+	switch r.Kind {
+	case KindLocalPath:
+		return nil, &Error{Registry: "npm", Reason: "local path identifiers are not allowed"}
+	case KindGitURL, KindTarballURL, KindAlias:
+		return r, nil
+	}
+
+	full := identifier
+	if r.Kind == KindScopedName {
+		full = r.Scope + "/" + r.Name
+	}
+	if len(full) > 214 {
+		return nil, &Error{Registry: "npm", Reason: "name must be 214 characters or fewer"}
+	}
+	if full != strings.ToLower(full) {
+		return nil, &Error{Registry: "npm", Reason: "name must be lowercase"}
+	}
+	name := r.Name
+	if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+		return nil, &Error{Registry: "npm", Reason: "name must not start with a dot or underscore"}
+	}
+	if !npmSafeChars.MatchString(name) {
+		return nil, &Error{Registry: "npm", Reason: "name contains characters that are not URL-safe"}
+	}
+	return r, nil
+}
+
+var npmSafeChars = regexp.MustCompile(`^[a-z0-9._~-]+$`)
+
+// ValidatePyPI enforces PEP 503 name normalization: letters, digits, and
+// ".-_", normalized by lowercasing and collapsing runs of "[-_.]" to a
+// single "-".
+func ValidatePyPI(identifier string) (*Result, error) {
+	r, err := Parse(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if r.Kind == KindLocalPath {
+		return nil, &Error{Registry: "pypi", Reason: "local path identifiers are not allowed"}
+	}
+	if !pypiNamePattern.MatchString(identifier) {
+		return nil, &Error{Registry: "pypi", Reason: "name must contain only letters, digits, '.', '-', and '_'"}
+	}
+	return r, nil
+}
+
+var pypiNamePattern = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9._-]*[A-Za-z0-9])?$`)
+
+// NormalizePyPI applies the PEP 503 normalization rule used to compare
+// two PyPI project names for equality.
+func NormalizePyPI(name string) string {
+	return strings.ToLower(pypiRunPattern.ReplaceAllString(name, "-"))
+}
+
+var pypiRunPattern = regexp.MustCompile(`[-_.]+`)
+
+// ValidateCargo enforces crates.io's name rules: [A-Za-z0-9_-], <=64
+// chars, must start with a letter.
+func ValidateCargo(identifier string) (*Result, error) {
+	r, err := Parse(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if r.Kind == KindLocalPath {
+		return nil, &Error{Registry: "cargo", Reason: "local path identifiers are not allowed"}
+	}
+	if len(identifier) > 64 {
+		return nil, &Error{Registry: "cargo", Reason: "name must be 64 characters or fewer"}
+	}
+	if !cargoNamePattern.MatchString(identifier) {
+		return nil, &Error{Registry: "cargo", Reason: "name must start with a letter and contain only letters, digits, '-', and '_'"}
+	}
+	return r, nil
+}
+
+var cargoNamePattern = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_-]*$`)
+
+// ValidateNuGet enforces the NuGet package ID rules: dot-separated
+// segments of letters, digits, '-', and '_'.
+func ValidateNuGet(identifier string) (*Result, error) {
+	r, err := Parse(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if r.Kind == KindLocalPath {
+		return nil, &Error{Registry: "nuget", Reason: "local path identifiers are not allowed"}
+	}
+	for _, segment := range strings.Split(identifier, ".") {
+		if segment == "" || !nugetSegmentPattern.MatchString(segment) {
+			return nil, &Error{Registry: "nuget", Reason: "id must be '.'-separated segments of letters, digits, '-', and '_'"}
+		}
+	}
+	return r, nil
+}
+
+var nugetSegmentPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ValidateOCI enforces the OCI/Docker reference-spec rules for the path
+// portion of an image reference: lowercase '/'-separated components.
+func ValidateOCI(identifier string) (*Result, error) {
+	r, err := Parse(identifier)
+	if err != nil {
+		return nil, err
+	}
+	if r.Kind == KindLocalPath {
+		return nil, &Error{Registry: "oci", Reason: "local path identifiers are not allowed"}
+	}
+	for _, component := range strings.Split(identifier, "/") {
+		if !ociComponentPattern.MatchString(component) {
+			return nil, &Error{Registry: "oci", Reason: "reference must be '/'-separated lowercase components"}
+		}
+	}
+	return r, nil
+}
+
+var ociComponentPattern = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|[-]+)[a-z0-9]+)*$`)
+
+// reservedVersionsByRegistry lists version strings that resolve to
+// "whatever is newest" rather than a pinned release, per registry.
+var reservedVersionsByRegistry = map[string]map[string]bool{
+	"npm": {"latest": true, "next": true},
+}
+
+// ReservedVersionError returns a structured error if version is a
+// reserved, non-pinned version string for the given registry type, and
+// nil otherwise.
+func ReservedVersionError(registryType, version string) error {
+	if reservedVersionsByRegistry[registryType][version] {
+		return &Error{Registry: registryType, Reason: fmt.Sprintf("%q is a reserved version string, not a pinned version", version)}
+	}
+	return nil
+}
+
+// Validate dispatches to the registry-specific identifier validator for
+// registryType. Registries without a dedicated validator (MCPB, whose
+// identifier is a download URL rather than a package name) are not
+// handled here.
+func Validate(registryType, identifier string) (*Result, error) {
+	switch registryType {
+	case "npm":
+		return ValidateNPM(identifier)
+	case "pypi":
+		return ValidatePyPI(identifier)
+	case "cargo":
+		return ValidateCargo(identifier)
+	case "nuget":
+		return ValidateNuGet(identifier)
+	case "oci":
+		return ValidateOCI(identifier)
+	default:
+		return Parse(identifier)
+	}
+}
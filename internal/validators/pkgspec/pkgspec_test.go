@@ -0,0 +1,108 @@
+package pkgspec_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		identifier string
+		wantKind   pkgspec.Kind
+	}{
+		{"unscoped name", "airtable-mcp-server", pkgspec.KindUnscopedName},
+		{"scoped name", "@modelcontextprotocol/server-filesystem", pkgspec.KindScopedName},
+		{"git url", "git+https://github.com/owner/repo.git", pkgspec.KindGitURL},
+		{"tarball url", "https://example.com/package.tgz", pkgspec.KindTarballURL},
+		{"alias", "npm:some-package@1.2.3", pkgspec.KindAlias},
+		{"local path", "./local/package", pkgspec.KindLocalPath},
+		{"file url local path", "file:../sibling-package", pkgspec.KindLocalPath},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := pkgspec.Parse(tt.identifier)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantKind, r.Kind)
+		})
+	}
+}
+
+func TestValidateNPM(t *testing.T) {
+	valid := []string{"airtable-mcp-server", "@modelcontextprotocol/server-filesystem", "a"}
+	for _, id := range valid {
+		t.Run("valid_"+id, func(t *testing.T) {
+			_, err := pkgspec.ValidateNPM(id)
+			assert.NoError(t, err)
+		})
+	}
+
+	invalid := []string{"Uppercase-Name", "_leading-underscore", ".leading-dot", "has a space"}
+	for _, id := range invalid {
+		t.Run("invalid_"+id, func(t *testing.T) {
+			_, err := pkgspec.ValidateNPM(id)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestValidatePyPI(t *testing.T) {
+	_, err := pkgspec.ValidatePyPI("time-mcp-pypi")
+	assert.NoError(t, err)
+
+	_, err = pkgspec.ValidatePyPI("time_mcp.pypi")
+	assert.NoError(t, err)
+
+	_, err = pkgspec.ValidatePyPI("has a space")
+	assert.Error(t, err)
+}
+
+func TestNormalizePyPI(t *testing.T) {
+	assert.Equal(t, "time-mcp-pypi", pkgspec.NormalizePyPI("Time_MCP.Pypi"))
+	assert.Equal(t, "time-mcp-pypi", pkgspec.NormalizePyPI("time---mcp__pypi"))
+}
+
+func TestValidateCargo(t *testing.T) {
+	_, err := pkgspec.ValidateCargo("serde_json")
+	assert.NoError(t, err)
+
+	_, err = pkgspec.ValidateCargo("1-starts-with-digit")
+	assert.Error(t, err)
+
+	long := ""
+	for i := 0; i < 65; i++ {
+		long += "a"
+	}
+	_, err = pkgspec.ValidateCargo(long)
+	assert.Error(t, err)
+}
+
+func TestValidateNuGet(t *testing.T) {
+	_, err := pkgspec.ValidateNuGet("TimeMcpServer")
+	assert.NoError(t, err)
+
+	_, err = pkgspec.ValidateNuGet("Time.Mcp.Server")
+	assert.NoError(t, err)
+
+	_, err = pkgspec.ValidateNuGet("Time..Server")
+	assert.Error(t, err)
+}
+
+func TestValidateOCI(t *testing.T) {
+	_, err := pkgspec.ValidateOCI("domdomegg/airtable-mcp-server")
+	assert.NoError(t, err)
+
+	_, err = pkgspec.ValidateOCI("Uppercase/not-allowed")
+	assert.Error(t, err)
+}
+
+func TestReservedVersionError(t *testing.T) {
+	assert.Error(t, pkgspec.ReservedVersionError("npm", "latest"))
+	assert.Error(t, pkgspec.ReservedVersionError("npm", "next"))
+	assert.NoError(t, pkgspec.ReservedVersionError("npm", "1.2.3"))
+	assert.NoError(t, pkgspec.ReservedVersionError("pypi", "latest"))
+}
@@ -0,0 +1,9 @@
+package validators
+
+import "github.com/modelcontextprotocol/registry/internal/validators/diag"
+
+// ProblemDetails and ProblemDetailsType are re-exported from diag; see
+// diag.ToProblemDetails.
+type ProblemDetails = diag.ProblemDetails
+
+const ProblemDetailsType = diag.ProblemDetailsType
@@ -0,0 +1,101 @@
+package provenance
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// namespaceIdentityPatterns maps a server name namespace prefix to a
+// glob pattern template (matched with matchGlob, where "*" matches any
+// run of characters, including "/") a covering Attestation's
+// CertificateIdentity must satisfy. The template's single "%s" is filled
+// in with the owner/group segment taken from the namespace itself (e.g.
+// "io.github.example" supplies "example"), so the pattern only matches
+// CI identities belonging to that owner, not any GitHub/GitLab repo. The
+// trailing "@*" accounts for the "@<ref>" suffix Fulcio always appends
+// to a GitHub/GitLab CI identity (e.g. "...release.yml@refs/tags/v1.0.0").
+// Only namespaces with a well-known CI convention are listed; server
+// names outside these namespaces have no required identity pattern.
+var namespaceIdentityPatterns = map[string]string{
+	"io.github.": "https://github.com/%s/*/.github/workflows/*.yml@*",
+	"io.gitlab.": "https://gitlab.com/%s/*/-/blob/*/.gitlab-ci.yml@*",
+}
+
+// matchGlob reports whether s matches pattern, where "*" in pattern
+// matches any run of characters (including none, and including "/").
+// This is looser than path.Match/filepath.Match, which stop "*" at a
+// path separator; a Fulcio certificate identity's workflow ref segment
+// can itself contain "/" (e.g. a tag ref), so the pattern's wildcards
+// need to cross it.
+func matchGlob(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return s == pattern
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
+
+// RequiredCertificateIdentityPattern returns the glob pattern a
+// covering Attestation's CertificateIdentity must match for serverName,
+// derived from its namespace (e.g. "io.github.example/foo" requires a
+// GitHub Actions workflow identity under github.com/example/...). ok is
+// false if serverName's namespace has no known CI convention, meaning
+// no attestation is required.
+func RequiredCertificateIdentityPattern(serverName string) (pattern string, ok bool) {
+	namespace, _, _ := strings.Cut(serverName, "/")
+	for prefix, tmpl := range namespaceIdentityPatterns {
+		if !strings.HasPrefix(namespace, prefix) {
+			continue
+		}
+		owner := strings.TrimPrefix(namespace, prefix)
+		if owner == "" {
+			return "", false
+		}
+		return fmt.Sprintf(tmpl, owner), true
+	}
+	return "", false
+}
+
+// ValidateAttestations checks attestations against the identity pattern
+// required for serverName's namespace. It returns nil without checking
+// anything if the namespace has no required pattern (see
+// RequiredCertificateIdentityPattern). Every attestation must come from
+// a trusted issuer and match the required pattern; at least one
+// attestation must be present if a pattern is required.
+//
+// This only checks the certificate identity and issuer recorded on each
+// Attestation. BundleURL is not fetched, and the Sigstore bundle's
+// signature is not verified; the SignatureVerifier installed with
+// RegisterSignatureVerifier is not called here.
+func ValidateAttestations(serverName string, attestations []model.Attestation) error {
+	pattern, ok := RequiredCertificateIdentityPattern(serverName)
+	if !ok {
+		return nil
+	}
+	if len(attestations) == 0 {
+		return fmt.Errorf("%w: %q", verr.ErrAttestationRequired, pattern)
+	}
+	for _, att := range attestations {
+		if !isTrustedIssuer(att.CertificateIssuer) {
+			return fmt.Errorf("%w: %s", verr.ErrUntrustedBuilderIdentity, att.CertificateIssuer)
+		}
+		if !matchGlob(pattern, att.CertificateIdentity) {
+			return fmt.Errorf("%w: %q does not match %q", verr.ErrAttestationIdentityMismatch, att.CertificateIdentity, pattern)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package provenance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+func TestRequiredCertificateIdentityPattern(t *testing.T) {
+	pattern, ok := RequiredCertificateIdentityPattern("io.github.example/foo-server")
+	assert.True(t, ok)
+	assert.Equal(t, "https://github.com/example/*/.github/workflows/*.yml@*", pattern)
+
+	_, ok = RequiredCertificateIdentityPattern("com.example/foo-server")
+	assert.False(t, ok)
+}
+
+func TestValidateAttestations_NoPolicyIsUnaffected(t *testing.T) {
+	err := ValidateAttestations("com.example/foo-server", nil)
+	assert.NoError(t, err)
+}
+
+func TestValidateAttestations_RequiredButMissing(t *testing.T) {
+	err := ValidateAttestations("io.github.example/foo-server", nil)
+	assert.ErrorIs(t, err, verr.ErrAttestationRequired)
+}
+
+func TestValidateAttestations_ValidBundle(t *testing.T) {
+	err := ValidateAttestations("io.github.example/foo-server", []model.Attestation{
+		{
+			BundleURL:           "https://example.com/bundle.sigstore.json",
+			CertificateIdentity: "https://github.com/example/foo-server/.github/workflows/release.yml@refs/tags/v1.0.0",
+			CertificateIssuer:   "https://token.actions.githubusercontent.com",
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidateAttestations_WrongIdentity(t *testing.T) {
+	err := ValidateAttestations("io.github.example/foo-server", []model.Attestation{
+		{
+			CertificateIdentity: "https://github.com/someone-else/other-repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+			CertificateIssuer:   "https://token.actions.githubusercontent.com",
+		},
+	})
+	assert.ErrorIs(t, err, verr.ErrAttestationIdentityMismatch)
+}
+
+func TestValidateAttestations_UntrustedIssuer(t *testing.T) {
+	err := ValidateAttestations("io.github.example/foo-server", []model.Attestation{
+		{
+			CertificateIdentity: "https://github.com/example/foo-server/.github/workflows/release.yml@refs/tags/v1.0.0",
+			CertificateIssuer:   "https://attacker.example.com",
+		},
+	})
+	assert.ErrorIs(t, err, verr.ErrUntrustedBuilderIdentity)
+}
+
+func TestMatchGlob(t *testing.T) {
+	testCases := []struct {
+		name    string
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"exact no wildcard match", "abc", "abc", true},
+		{"exact no wildcard mismatch", "abc", "abd", false},
+		{"single wildcard crosses slash", "https://github.com/*/*/.github/workflows/*.yml", "https://github.com/example/repo/.github/workflows/release.yml", true},
+		{"wildcard crosses embedded slash", "https://github.com/*/*/.github/workflows/*", "https://github.com/example/repo/.github/workflows/release.yml@refs/tags/v1.0.0", true},
+		{"missing prefix", "https://github.com/*", "https://gitlab.com/example/repo", false},
+		{"missing required middle segment", "a*b*c", "ac", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, matchGlob(tc.pattern, tc.s))
+		})
+	}
+}
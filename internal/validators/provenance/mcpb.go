@@ -0,0 +1,66 @@
+package provenance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// githubAPIBaseURL is the default GitHub REST API base used to look up
+// build attestations for MCPB packages.
+const githubAPIBaseURL = "https://api.github.com"
+
+// mcpbVerifier fetches the build attestation for an MCPB package's
+// download URL from the GitHub Actions attestations API
+// (https://docs.github.com/en/rest/repos/repos#get-an-attestation),
+// keyed by its owner/repo and FileSHA256 digest.
+type mcpbVerifier struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (v *mcpbVerifier) Verify(ctx context.Context, pkg model.Package, repo model.Repository) error {
+	ownerRepo, err := githubOwnerRepo(pkg.Identifier)
+	if err != nil {
+		return err
+	}
+	if pkg.FileSHA256 == "" {
+		return verr.ErrTransparencyLogEntryNotFound
+	}
+
+	baseURL := v.baseURL
+	if baseURL == "" {
+		baseURL = githubAPIBaseURL
+	}
+	attestationURL := fmt.Sprintf("%s/repos/%s/attestations/sha256:%s", baseURL, ownerRepo, pkg.FileSHA256)
+
+	var body struct {
+		AttestationDocuments []attestationDocument `json:"attestations"`
+	}
+	if err := fetchJSON(ctx, v.client, attestationURL, &body); err != nil {
+		return err
+	}
+	if len(body.AttestationDocuments) == 0 {
+		return verr.ErrTransparencyLogEntryNotFound
+	}
+	return checkAttestation(ctx, &body.AttestationDocuments[0], pkg, repo)
+}
+
+// githubOwnerRepo extracts "owner/repo" from an MCPB download URL such
+// as "https://github.com/owner/repo/releases/download/v1.0.0/x.mcpb".
+func githubOwnerRepo(identifier string) (string, error) {
+	u, err := url.Parse(identifier)
+	if err != nil || u.Host == "" {
+		return "", fmt.Errorf("mcpb package identifier must be a download url, got %q", identifier)
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 2 {
+		return "", fmt.Errorf("could not determine owner/repo from mcpb identifier %q", identifier)
+	}
+	return segments[0] + "/" + segments[1], nil
+}
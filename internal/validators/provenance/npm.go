@@ -0,0 +1,49 @@
+package provenance
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// npmVerifier fetches the npm provenance attestation bundle referenced
+// by a published version's "dist.attestations.url" field, per npm's
+// provenance support (https://docs.npmjs.com/generating-provenance-statements).
+type npmVerifier struct {
+	client *http.Client
+}
+
+func (v *npmVerifier) Verify(ctx context.Context, pkg model.Package, repo model.Repository) error {
+	baseURL := pkg.RegistryBaseURL
+	if baseURL == "" {
+		baseURL = model.RegistryURLNPM
+	}
+	metadataURL := strings.TrimSuffix(baseURL, "/") + "/" + strings.ReplaceAll(pkg.Identifier, "/", "%2F")
+
+	var metadata struct {
+		Versions map[string]struct {
+			Dist struct {
+				Attestations struct {
+					URL string `json:"url"`
+				} `json:"attestations"`
+			} `json:"dist"`
+		} `json:"versions"`
+	}
+	if err := fetchJSON(ctx, v.client, metadataURL, &metadata); err != nil {
+		return err
+	}
+
+	version, ok := metadata.Versions[pkg.Version]
+	if !ok || version.Dist.Attestations.URL == "" {
+		return verr.ErrTransparencyLogEntryNotFound
+	}
+
+	var doc attestationDocument
+	if err := fetchJSON(ctx, v.client, version.Dist.Attestations.URL, &doc); err != nil {
+		return err
+	}
+	return checkAttestation(ctx, &doc, pkg, repo)
+}
@@ -0,0 +1,39 @@
+package provenance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// rekorURL is the default Sigstore public transparency log used to look
+// up cosign attestation bundles for OCI packages.
+const rekorURL = "https://rekor.sigstore.dev/api/v1/log/entries"
+
+// ociVerifier looks up the cosign attestation bundle recorded for an OCI
+// package's Provenance.TransparencyLogID in the Rekor transparency log.
+type ociVerifier struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (v *ociVerifier) Verify(ctx context.Context, pkg model.Package, repo model.Repository) error {
+	if pkg.Provenance.TransparencyLogID == "" {
+		return verr.ErrTransparencyLogEntryNotFound
+	}
+
+	baseURL := v.baseURL
+	if baseURL == "" {
+		baseURL = rekorURL
+	}
+	entryURL := fmt.Sprintf("%s/%s", baseURL, pkg.Provenance.TransparencyLogID)
+
+	var doc attestationDocument
+	if err := fetchJSON(ctx, v.client, entryURL, &doc); err != nil {
+		return err
+	}
+	return checkAttestation(ctx, &doc, pkg, repo)
+}
@@ -0,0 +1,164 @@
+// Package provenance verifies a package's SLSA/in-toto build provenance
+// statement against the registry (or transparency log) it was published
+// through, so a publish can be rejected if the statement's subject
+// digest, builder identity, or source repository doesn't match what the
+// publisher declared. Each registry type that can carry provenance gets
+// its own Verifier, following the same plugin shape as
+// internal/validators/datasource.
+package provenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// Verifier checks a package's declared Provenance against its issuing
+// registry or transparency log.
+type Verifier interface {
+	Verify(ctx context.Context, pkg model.Package, repo model.Repository) error
+}
+
+var (
+	mu        sync.RWMutex
+	verifiers = map[string]Verifier{}
+)
+
+// Register adds or replaces the Verifier used for a registry type.
+func Register(registryType string, v Verifier) {
+	mu.Lock()
+	defer mu.Unlock()
+	verifiers[registryType] = v
+}
+
+// Lookup returns the Verifier registered for a registry type.
+func Lookup(registryType string) (Verifier, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	v, ok := verifiers[registryType]
+	return v, ok
+}
+
+func init() {
+	client := &http.Client{Timeout: 10 * time.Second}
+	Register(model.RegistryTypeNPM, &npmVerifier{client: client})
+	Register(model.RegistryTypeMCPB, &mcpbVerifier{client: client})
+	Register(model.RegistryTypeOCI, &ociVerifier{client: client})
+}
+
+var (
+	trustedIssuersMu sync.RWMutex
+	trustedIssuers   = map[string]bool{
+		"https://token.actions.githubusercontent.com": true,
+	}
+)
+
+// RegisterTrustedIssuer adds an OIDC issuer to the set of identity
+// providers whose signing certificates are accepted as the Fulcio root
+// for a builder identity. Registries that build on another CI platform
+// (e.g. a self-hosted GitLab) call this during startup.
+func RegisterTrustedIssuer(issuer string) {
+	trustedIssuersMu.Lock()
+	defer trustedIssuersMu.Unlock()
+	trustedIssuers[issuer] = true
+}
+
+func isTrustedIssuer(issuer string) bool {
+	trustedIssuersMu.RLock()
+	defer trustedIssuersMu.RUnlock()
+	return trustedIssuers[issuer]
+}
+
+// SignatureVerifier cryptographically verifies an attestation's DSSE
+// envelope signature against the issuer's Fulcio certificate chain.
+// This package's default implementation only checks the statement's
+// content fields (subject digest, builder identity, source repo) and
+// that it resolves to a transparency log entry; it does not vendor a
+// Sigstore client, so raw signature verification is left as this
+// extension point for registries that need it.
+type SignatureVerifier interface {
+	VerifySignature(ctx context.Context, statement attestationDocument) error
+}
+
+type noopSignatureVerifier struct{}
+
+func (noopSignatureVerifier) VerifySignature(context.Context, attestationDocument) error { return nil }
+
+var signatureVerifier SignatureVerifier = noopSignatureVerifier{}
+
+// RegisterSignatureVerifier installs the SignatureVerifier used by
+// Verify after content checks pass.
+func RegisterSignatureVerifier(v SignatureVerifier) {
+	signatureVerifier = v
+}
+
+// attestationDocument is the subset of a fetched in-toto/SLSA statement
+// that Verify checks against the package's declared Provenance.
+type attestationDocument struct {
+	Issuer            string `json:"issuer"`
+	SubjectDigest     string `json:"subjectDigest"`
+	BuilderID         string `json:"builderId"`
+	SourceRepoURI     string `json:"sourceRepoUri"`
+	SourceCommit      string `json:"sourceCommit"`
+	TransparencyLogID string `json:"transparencyLogId"`
+}
+
+// Verify fetches the attestation for pkg from its registry's datasource
+// and checks it against pkg's declared Provenance and repo. It returns
+// nil without making any network calls if pkg.Provenance is unset, or
+// if no Verifier is registered for pkg.RegistryType.
+func Verify(ctx context.Context, pkg model.Package, repo model.Repository) error {
+	if pkg.Provenance == nil {
+		return nil
+	}
+	v, ok := Lookup(pkg.RegistryType)
+	if !ok {
+		return nil
+	}
+	return v.Verify(ctx, pkg, repo)
+}
+
+// checkAttestation applies the checks common to every registry type's
+// Verifier once it has fetched the attestation document.
+func checkAttestation(ctx context.Context, doc *attestationDocument, pkg model.Package, repo model.Repository) error {
+	if !isTrustedIssuer(doc.Issuer) {
+		return fmt.Errorf("%w: %s", verr.ErrUntrustedBuilderIdentity, doc.Issuer)
+	}
+	if pkg.FileSHA256 != "" {
+		digest := strings.TrimPrefix(doc.SubjectDigest, "sha256:")
+		if digest != pkg.FileSHA256 {
+			return fmt.Errorf("%w: attestation digest %s, package fileSha256 %s", verr.ErrProvenanceSubjectDigestMismatch, digest, pkg.FileSHA256)
+		}
+	}
+	if repo.URL != "" && doc.SourceRepoURI != "" &&
+		!strings.EqualFold(strings.TrimSuffix(doc.SourceRepoURI, ".git"), strings.TrimSuffix(repo.URL, ".git")) {
+		return fmt.Errorf("%w: attestation source %s, repository %s", verr.ErrProvenanceSourceMismatch, doc.SourceRepoURI, repo.URL)
+	}
+	return signatureVerifier.VerifySignature(ctx, *doc)
+}
+
+func fetchJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return verr.ErrTransparencyLogEntryNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d fetching attestation from %s", resp.StatusCode, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
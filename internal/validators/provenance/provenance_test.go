@@ -0,0 +1,206 @@
+package provenance
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+const testDigest = "abc123"
+
+func validAttestation() string {
+	return `{
+		"issuer": "https://token.actions.githubusercontent.com",
+		"subjectDigest": "sha256:` + testDigest + `",
+		"builderId": "https://github.com/actions/runner",
+		"sourceRepoUri": "https://github.com/example/repo",
+		"sourceCommit": "deadbeef",
+		"transparencyLogId": "1234"
+	}`
+}
+
+func TestVerify_NoProvenance(t *testing.T) {
+	err := Verify(context.Background(), model.Package{RegistryType: model.RegistryTypeNPM}, model.Repository{})
+	assert.NoError(t, err)
+}
+
+func TestVerify_NoRegisteredVerifier(t *testing.T) {
+	pkg := model.Package{
+		RegistryType: "not-a-real-registry",
+		Provenance:   &model.Provenance{Issuer: "https://example.com"},
+	}
+	err := Verify(context.Background(), pkg, model.Repository{})
+	assert.NoError(t, err)
+}
+
+func TestNpmVerifier_Verify(t *testing.T) {
+	attestationSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(validAttestation()))
+	}))
+	defer attestationSrv.Close()
+
+	registrySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"versions":{"1.0.0":{"dist":{"attestations":{"url":"` + attestationSrv.URL + `"}}}}}`))
+	}))
+	defer registrySrv.Close()
+
+	v := &npmVerifier{client: registrySrv.Client()}
+	pkg := model.Package{
+		RegistryType:    model.RegistryTypeNPM,
+		RegistryBaseURL: registrySrv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		FileSHA256:      testDigest,
+		Provenance:      &model.Provenance{Issuer: "https://token.actions.githubusercontent.com"},
+	}
+	repo := model.Repository{URL: "https://github.com/example/repo"}
+	err := v.Verify(context.Background(), pkg, repo)
+	require.NoError(t, err)
+}
+
+func TestNpmVerifier_VersionNotPublished(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"versions":{}}`))
+	}))
+	defer srv.Close()
+
+	v := &npmVerifier{client: srv.Client()}
+	pkg := model.Package{
+		RegistryBaseURL: srv.URL,
+		Identifier:      "some-pkg",
+		Version:         "1.0.0",
+		Provenance:      &model.Provenance{Issuer: "https://token.actions.githubusercontent.com"},
+	}
+	err := v.Verify(context.Background(), pkg, model.Repository{})
+	assert.ErrorIs(t, err, verr.ErrTransparencyLogEntryNotFound)
+}
+
+func TestMcpbVerifier_Verify(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"attestations":[` + validAttestation() + `]}`))
+	}))
+	defer srv.Close()
+
+	v := &mcpbVerifier{client: srv.Client(), baseURL: srv.URL}
+	pkg := model.Package{
+		RegistryType: model.RegistryTypeMCPB,
+		Identifier:   "https://github.com/example/repo/releases/download/v1.0.0/x.mcpb",
+		FileSHA256:   testDigest,
+		Provenance:   &model.Provenance{Issuer: "https://token.actions.githubusercontent.com"},
+	}
+	repo := model.Repository{URL: "https://github.com/example/repo"}
+	err := v.Verify(context.Background(), pkg, repo)
+	require.NoError(t, err)
+}
+
+func TestMcpbVerifier_UntrustedIssuer(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"attestations":[{
+			"issuer": "https://attacker.example.com",
+			"subjectDigest": "sha256:` + testDigest + `",
+			"builderId": "x",
+			"sourceRepoUri": "https://github.com/example/repo"
+		}]}`))
+	}))
+	defer srv.Close()
+
+	v := &mcpbVerifier{client: srv.Client(), baseURL: srv.URL}
+	pkg := model.Package{
+		Identifier: "https://github.com/example/repo/releases/download/v1.0.0/x.mcpb",
+		FileSHA256: testDigest,
+		Provenance: &model.Provenance{Issuer: "https://attacker.example.com"},
+	}
+	err := v.Verify(context.Background(), pkg, model.Repository{})
+	assert.ErrorIs(t, err, verr.ErrUntrustedBuilderIdentity)
+}
+
+func TestMcpbVerifier_DigestMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"attestations":[` + validAttestation() + `]}`))
+	}))
+	defer srv.Close()
+
+	v := &mcpbVerifier{client: srv.Client(), baseURL: srv.URL}
+	pkg := model.Package{
+		Identifier: "https://github.com/example/repo/releases/download/v1.0.0/x.mcpb",
+		FileSHA256: "different-digest",
+		Provenance: &model.Provenance{Issuer: "https://token.actions.githubusercontent.com"},
+	}
+	err := v.Verify(context.Background(), pkg, model.Repository{})
+	assert.ErrorIs(t, err, verr.ErrProvenanceSubjectDigestMismatch)
+}
+
+func TestMcpbVerifier_SourceMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"attestations":[` + validAttestation() + `]}`))
+	}))
+	defer srv.Close()
+
+	v := &mcpbVerifier{client: srv.Client(), baseURL: srv.URL}
+	pkg := model.Package{
+		Identifier: "https://github.com/example/repo/releases/download/v1.0.0/x.mcpb",
+		FileSHA256: testDigest,
+		Provenance: &model.Provenance{Issuer: "https://token.actions.githubusercontent.com"},
+	}
+	repo := model.Repository{URL: "https://github.com/other/repo"}
+	err := v.Verify(context.Background(), pkg, repo)
+	assert.ErrorIs(t, err, verr.ErrProvenanceSourceMismatch)
+}
+
+func TestMcpbVerifier_InvalidIdentifier(t *testing.T) {
+	v := &mcpbVerifier{client: http.DefaultClient}
+	pkg := model.Package{Identifier: "not-a-url", FileSHA256: testDigest}
+	err := v.Verify(context.Background(), pkg, model.Repository{})
+	assert.Error(t, err)
+}
+
+func TestOciVerifier_Verify(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(validAttestation()))
+	}))
+	defer srv.Close()
+
+	v := &ociVerifier{client: srv.Client(), baseURL: srv.URL}
+	pkg := model.Package{
+		RegistryType: model.RegistryTypeOCI,
+		FileSHA256:   testDigest,
+		Provenance: &model.Provenance{
+			Issuer:            "https://token.actions.githubusercontent.com",
+			TransparencyLogID: "1234",
+		},
+	}
+	repo := model.Repository{URL: "https://github.com/example/repo"}
+	err := v.Verify(context.Background(), pkg, repo)
+	require.NoError(t, err)
+}
+
+func TestOciVerifier_MissingLogID(t *testing.T) {
+	v := &ociVerifier{client: http.DefaultClient}
+	pkg := model.Package{Provenance: &model.Provenance{}}
+	err := v.Verify(context.Background(), pkg, model.Repository{})
+	assert.ErrorIs(t, err, verr.ErrTransparencyLogEntryNotFound)
+}
+
+func TestFetchJSON_NotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	var v map[string]any
+	err := fetchJSON(context.Background(), srv.Client(), srv.URL, &v)
+	assert.ErrorIs(t, err, verr.ErrTransparencyLogEntryNotFound)
+}
+
+func TestRegisterTrustedIssuer(t *testing.T) {
+	RegisterTrustedIssuer("https://ci.example.com")
+	assert.True(t, isTrustedIssuer("https://ci.example.com"))
+	assert.False(t, isTrustedIssuer("https://untrusted.example.com"))
+}
@@ -0,0 +1,107 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+func TestValidate_Provenance(t *testing.T) {
+	baseServer := func(pkg model.Package) apiv0.ServerJSON {
+		return apiv0.ServerJSON{
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Repository: model.Repository{
+				URL:    "https://github.com/example/repo",
+				Source: "github",
+				ID:     "example/repo",
+			},
+			Packages: []model.Package{pkg},
+		}
+	}
+
+	t.Run("no provenance is unaffected", func(t *testing.T) {
+		s := baseServer(model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "some-pkg",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: "stdio"},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		require.NoError(t, err)
+	})
+
+	t.Run("provenance missing issuer or builderId is rejected", func(t *testing.T) {
+		s := baseServer(model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "some-pkg",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: "stdio"},
+			Provenance:   &model.Provenance{Issuer: "https://token.actions.githubusercontent.com"},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		assert.Error(t, err)
+	})
+
+	t.Run("provenance digest mismatching FileSHA256 is rejected", func(t *testing.T) {
+		s := baseServer(model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "some-pkg",
+			Version:      "1.0.0",
+			FileSHA256:   "abc123",
+			Transport:    model.Transport{Type: "stdio"},
+			Provenance: &model.Provenance{
+				Issuer:        "https://token.actions.githubusercontent.com",
+				BuilderID:     "https://github.com/actions/runner",
+				SubjectDigest: "sha256:different",
+			},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		assert.ErrorIs(t, err, validators.ErrProvenanceSubjectDigestMismatch)
+	})
+
+	t.Run("provenance source repository mismatching Repository.URL is rejected", func(t *testing.T) {
+		s := baseServer(model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "some-pkg",
+			Version:      "1.0.0",
+			Transport:    model.Transport{Type: "stdio"},
+			Provenance: &model.Provenance{
+				Issuer:        "https://token.actions.githubusercontent.com",
+				BuilderID:     "https://github.com/actions/runner",
+				SourceRepoURI: "https://github.com/other/repo",
+			},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		assert.ErrorIs(t, err, validators.ErrProvenanceSourceMismatch)
+	})
+
+	t.Run("matching provenance passes structural validation", func(t *testing.T) {
+		s := baseServer(model.Package{
+			RegistryType: model.RegistryTypeNPM,
+			Identifier:   "some-pkg",
+			Version:      "1.0.0",
+			FileSHA256:   "abc123",
+			Transport:    model.Transport{Type: "stdio"},
+			Provenance: &model.Provenance{
+				Issuer:        "https://token.actions.githubusercontent.com",
+				BuilderID:     "https://github.com/actions/runner",
+				SubjectDigest: "sha256:abc123",
+				SourceRepoURI: "https://github.com/example/repo",
+			},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,155 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/registry/internal/config"
+	"github.com/modelcontextprotocol/registry/internal/validators/datasource"
+	"github.com/modelcontextprotocol/registry/internal/validators/diag"
+	"github.com/modelcontextprotocol/registry/internal/validators/packages"
+	"github.com/modelcontextprotocol/registry/internal/validators/provenance"
+	"github.com/modelcontextprotocol/registry/internal/validators/schema/v01"
+	"github.com/modelcontextprotocol/registry/internal/validators/snapshot"
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// ValidatePublishRequest validates a ServerJSON submitted to /v0/publish.
+// It runs the same checks as ValidateServerJSON for the document's
+// declared (or default) $schemaVersion, plus any checks that depend on
+// registry configuration (such as the generic git repository fallback and
+// upstream existence verification), collecting every diagnostic into one
+// ValidationErrors rather than stopping at the first, so a publisher sees
+// every problem from a single rejected request. The network-dependent
+// checks only run once the document itself is schema-valid, since they
+// need well-formed package identifiers to look anything up. ctx is
+// threaded through for the benefit of validation phases that need to make
+// network calls.
+//
+// Once the document is schema-valid, if cfg.RequireNamespaceAttestations
+// is set, s.Attestations is checked against the identity pattern
+// required for s.Name's namespace; see provenance.ValidateAttestations.
+// This is a content check rather than a network one, but it's still
+// opt-in: io.github.*/io.gitlab.* is the dominant real-world namespace
+// pattern, so enforcing it unconditionally would reject every existing
+// publisher that hasn't started attaching Sigstore attestations yet.
+//
+// cfg.RegistryValidationMode selects how those checks are performed:
+// RegistryValidationModeOnline (the default) reaches out to the live
+// upstream registry, downloads and hashes each package's artifact to
+// check it against a declared FileSHA256, and verifies build provenance
+// via Rekor/GitHub; RegistryValidationModeOffline skips all of that; and
+// RegistryValidationModeSnapshot resolves package existence and expected
+// digests against cfg.SnapshotStore instead. Provenance can't be checked
+// against a snapshot (it needs a live transparency log), so it's skipped
+// in both the offline and snapshot modes.
+func ValidatePublishRequest(ctx context.Context, s apiv0.ServerJSON, cfg *config.Config) error {
+	validate, ok := schemaReportValidators[s.SchemaVersion]
+	if !ok {
+		return fmt.Errorf("%w: %q", ErrUnsupportedSchemaVersion, s.SchemaVersion)
+	}
+
+	opts := v01.Options{}
+	if cfg != nil {
+		opts.GenericGitFallback = cfg.EnableGenericGitRepositoryFallback
+	}
+	report := validate(&s, opts)
+
+	if !report.HasErrors() && cfg != nil && cfg.RequireNamespaceAttestations {
+		if err := provenance.ValidateAttestations(s.Name, s.Attestations); err != nil {
+			code := "server.attestation.invalid"
+			switch {
+			case errors.Is(err, verr.ErrAttestationRequired):
+				code = "server.attestation.required"
+			case errors.Is(err, verr.ErrAttestationIdentityMismatch):
+				code = "server.attestation.identity_mismatch"
+			case errors.Is(err, verr.ErrUntrustedBuilderIdentity):
+				code = "server.attestation.untrusted_issuer"
+			}
+			report.Add(diag.NewError(code, "/attestations", err))
+		}
+	}
+
+	if cfg != nil && cfg.EnableRegistryValidation && !report.HasErrors() {
+		switch cfg.RegistryValidationMode {
+		case config.RegistryValidationModeOffline:
+			// No network, no snapshot: existence and provenance are
+			// trusted as declared.
+		case config.RegistryValidationModeSnapshot:
+			verifyAgainstSnapshot(&report, s.Packages, cfg.SnapshotStore)
+		default:
+			if err := datasource.VerifyAll(ctx, s.Packages, 0); err != nil {
+				report.Add(diag.NewError("package.registry.unverifiable", "/packages", err))
+			}
+			pkgOpts := packages.Options{MaxArtifactSize: cfg.MaxArtifactSizeBytes, Cache: cfg.ArtifactDigestCache}
+			for i, pkg := range s.Packages {
+				path := fmt.Sprintf("/packages/%d", i)
+				if err := provenance.Verify(ctx, pkg, s.Repository); err != nil {
+					report.Add(diag.NewError("package.provenance.invalid", path, err))
+				}
+				if err := packages.VerifyArtifact(ctx, pkg, pkgOpts); err != nil {
+					code := "package.digest.invalid"
+					switch {
+					case errors.Is(err, packages.ErrDigestMismatch):
+						code = "package.digest.mismatch"
+					case errors.Is(err, packages.ErrArtifactTooLarge):
+						code = "package.digest.too_large"
+					}
+					report.Add(diag.NewError(code, path, err))
+				}
+			}
+		}
+	}
+
+	if !report.HasErrors() {
+		return nil
+	}
+	return report
+}
+
+// verifyAgainstSnapshot resolves each package's existence and, when a
+// FileSHA256 was declared, its expected digest against store instead of
+// the network, appending a diagnostic for anything the snapshot can't
+// confirm.
+func verifyAgainstSnapshot(report *diag.Report, packages []model.Package, store snapshot.Store) {
+	if store == nil {
+		report.Add(diag.NewError("package.snapshot.unconfigured", "/packages",
+			errors.New("registry validation mode is \"snapshot\" but no SnapshotStore was configured")))
+		return
+	}
+
+	for i, pkg := range packages {
+		path := fmt.Sprintf("/packages/%d", i)
+
+		exists, err := store.Exists(pkg.RegistryType, pkg.Identifier, pkg.Version)
+		if err != nil {
+			report.Add(diag.NewError("package.snapshot.lookup_failed", path, err))
+			continue
+		}
+		if !exists {
+			report.Add(diag.NewError("package.snapshot.not_captured", path,
+				fmt.Errorf("%w: %s/%s@%s", snapshot.ErrNotCaptured, pkg.RegistryType, pkg.Identifier, pkg.Version)))
+			continue
+		}
+
+		if pkg.FileSHA256 == "" {
+			continue
+		}
+		want, err := store.SHA256(pkg.RegistryType, pkg.Identifier, pkg.Version)
+		if errors.Is(err, snapshot.ErrNotCaptured) {
+			continue
+		}
+		if err != nil {
+			report.Add(diag.NewError("package.snapshot.lookup_failed", path, err))
+			continue
+		}
+		if want != pkg.FileSHA256 {
+			report.Add(diag.NewError("package.snapshot.digest_mismatch", path,
+				fmt.Errorf("expected sha256:%s, got sha256:%s", want, pkg.FileSHA256)).
+				WithDetails(map[string]any{"expected": want, "got": pkg.FileSHA256}))
+		}
+	}
+}
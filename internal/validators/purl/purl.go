@@ -0,0 +1,294 @@
+// Package purl parses and serializes Package URLs (purl), as defined by
+// https://github.com/package-url/purl-spec, and converts between a
+// PackageURL and this registry's Package registry fields (RegistryType,
+// Identifier, Version, RegistryBaseURL).
+package purl
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// ErrInvalidPurl is returned when a string doesn't parse as a
+// well-formed purl.
+var ErrInvalidPurl = errors.New("invalid package url")
+
+// PackageURL is a parsed, normalized Package URL.
+type PackageURL struct {
+	Type       string
+	Namespace  string
+	Name       string
+	Version    string
+	Qualifiers map[string]string
+	Subpath    string
+}
+
+// Parse parses s as a purl string (e.g. "pkg:npm/@scope/name@1.0.0") and
+// applies the type-specific normalization rules from the purl spec
+// (lowercase type, lowercased namespace/name for npm and pypi, `_`
+// folded to `-` for pypi).
+func Parse(s string) (*PackageURL, error) {
+	rest := strings.TrimPrefix(s, "pkg:")
+	if rest == s {
+		return nil, fmt.Errorf("%w: missing \"pkg:\" scheme: %q", ErrInvalidPurl, s)
+	}
+	rest = strings.TrimPrefix(rest, "//")
+
+	var subpath string
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		subpath = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	var rawQualifiers string
+	if i := strings.IndexByte(rest, '?'); i >= 0 {
+		rawQualifiers = rest[i+1:]
+		rest = rest[:i]
+	}
+
+	segments := strings.Split(rest, "/")
+	if len(segments) < 2 {
+		return nil, fmt.Errorf("%w: expected \"type/[namespace/]name[@version]\": %q", ErrInvalidPurl, s)
+	}
+
+	typ := strings.ToLower(segments[0])
+	if typ == "" {
+		return nil, fmt.Errorf("%w: type is required: %q", ErrInvalidPurl, s)
+	}
+
+	last := segments[len(segments)-1]
+	name, version, _ := strings.Cut(last, "@")
+
+	decodedName, err := decodeComponent(name)
+	if err != nil || decodedName == "" {
+		return nil, fmt.Errorf("%w: invalid name: %q", ErrInvalidPurl, s)
+	}
+
+	decodedVersion, err := decodeComponent(version)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid version: %q", ErrInvalidPurl, s)
+	}
+
+	namespaceSegments := segments[1 : len(segments)-1]
+	for i, seg := range namespaceSegments {
+		decoded, err := decodeComponent(seg)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid namespace: %q", ErrInvalidPurl, s)
+		}
+		namespaceSegments[i] = decoded
+	}
+	namespace := strings.Join(namespaceSegments, "/")
+
+	qualifiers, err := parseQualifiers(rawQualifiers)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid qualifiers: %q", ErrInvalidPurl, s)
+	}
+
+	decodedSubpath, err := decodeComponent(subpath)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid subpath: %q", ErrInvalidPurl, s)
+	}
+
+	p := &PackageURL{
+		Type:       typ,
+		Namespace:  namespace,
+		Name:       decodedName,
+		Version:    decodedVersion,
+		Qualifiers: qualifiers,
+		Subpath:    normalizeSubpath(decodedSubpath),
+	}
+	p.normalize()
+	return p, nil
+}
+
+func decodeComponent(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	return url.PathUnescape(s)
+}
+
+func parseQualifiers(raw string) (map[string]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	qualifiers := make(map[string]string)
+	for _, pair := range strings.Split(raw, "&") {
+		if pair == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(pair, "=")
+		decodedValue, err := url.QueryUnescape(value)
+		if err != nil {
+			return nil, err
+		}
+		if decodedValue == "" {
+			continue
+		}
+		qualifiers[strings.ToLower(key)] = decodedValue
+	}
+	return qualifiers, nil
+}
+
+// normalizeSubpath strips leading/trailing slashes and "." / ".."
+// segments, per the purl spec.
+func normalizeSubpath(subpath string) string {
+	var kept []string
+	for _, seg := range strings.Split(subpath, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			continue
+		}
+		kept = append(kept, seg)
+	}
+	return strings.Join(kept, "/")
+}
+
+// normalize applies type-specific casing rules required by the purl
+// spec for the registry types this package knows how to map.
+func (p *PackageURL) normalize() {
+	switch p.Type {
+	case "npm":
+		p.Namespace = strings.ToLower(p.Namespace)
+		p.Name = strings.ToLower(p.Name)
+	case "pypi":
+		p.Namespace = strings.ToLower(p.Namespace)
+		p.Name = strings.ToLower(strings.ReplaceAll(p.Name, "_", "-"))
+	case "oci":
+		p.Namespace = strings.ToLower(p.Namespace)
+		p.Name = strings.ToLower(p.Name)
+	}
+}
+
+// escapeSegment percent-encodes a path segment per the purl spec. It
+// additionally encodes "@", which url.PathEscape leaves untouched but
+// which must be escaped here since it's also the name/version separator.
+func escapeSegment(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
+
+// String renders p back to its canonical purl string: lowercase type,
+// percent-encoded components, and qualifiers sorted by key.
+func (p *PackageURL) String() string {
+	var b strings.Builder
+	b.WriteString("pkg:")
+	b.WriteString(p.Type)
+	b.WriteByte('/')
+	if p.Namespace != "" {
+		for _, seg := range strings.Split(p.Namespace, "/") {
+			b.WriteString(escapeSegment(seg))
+			b.WriteByte('/')
+		}
+	}
+	b.WriteString(escapeSegment(p.Name))
+	if p.Version != "" {
+		b.WriteByte('@')
+		b.WriteString(escapeSegment(p.Version))
+	}
+	if len(p.Qualifiers) > 0 {
+		keys := make([]string, 0, len(p.Qualifiers))
+		for k := range p.Qualifiers {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		b.WriteByte('?')
+		for i, k := range keys {
+			if i > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(k)
+			b.WriteByte('=')
+			b.WriteString(url.QueryEscape(p.Qualifiers[k]))
+		}
+	}
+	if p.Subpath != "" {
+		b.WriteByte('#')
+		b.WriteString(p.Subpath)
+	}
+	return b.String()
+}
+
+// registryTypeByPurlType maps a purl type to the model.RegistryType this
+// registry uses for it. Only registry types the registry already
+// understands are mapped; other purl types (e.g. "golang", "cargo")
+// round-trip through PackageURL but can't be converted to/from Package
+// fields yet.
+var registryTypeByPurlType = map[string]string{
+	"npm":   model.RegistryTypeNPM,
+	"pypi":  model.RegistryTypePyPI,
+	"oci":   model.RegistryTypeOCI,
+	"nuget": model.RegistryTypeNuGet,
+}
+
+var purlTypeByRegistryType = map[string]string{
+	model.RegistryTypeNPM:   "npm",
+	model.RegistryTypePyPI:  "pypi",
+	model.RegistryTypeOCI:   "oci",
+	model.RegistryTypeNuGet: "nuget",
+}
+
+// PackageFields is the subset of model.Package that a purl maps to and
+// from.
+type PackageFields struct {
+	RegistryType    string
+	RegistryBaseURL string
+	Identifier      string
+	Version         string
+}
+
+// ToPackageFields converts p to the registry fields an equivalent
+// model.Package would use.
+func (p *PackageURL) ToPackageFields() (PackageFields, error) {
+	registryType, ok := registryTypeByPurlType[p.Type]
+	if !ok {
+		return PackageFields{}, fmt.Errorf("%w: unsupported purl type %q", ErrInvalidPurl, p.Type)
+	}
+
+	identifier := p.Name
+	if p.Namespace != "" {
+		// The npm namespace is the "@scope" and already carries its "@"
+		// prefix (see TYPES.rst in the purl spec), so it joins directly
+		// onto the name; other registry types just use "namespace/name".
+		identifier = p.Namespace + "/" + p.Name
+	}
+
+	return PackageFields{
+		RegistryType:    registryType,
+		RegistryBaseURL: p.Qualifiers["repository_url"],
+		Identifier:      identifier,
+		Version:         p.Version,
+	}, nil
+}
+
+// FromPackageFields builds the PackageURL equivalent to a model.Package's
+// registry fields, the inverse of ToPackageFields.
+func FromPackageFields(f PackageFields) (*PackageURL, error) {
+	typ, ok := purlTypeByRegistryType[f.RegistryType]
+	if !ok {
+		return nil, fmt.Errorf("%w: registry type %q has no purl equivalent", ErrInvalidPurl, f.RegistryType)
+	}
+
+	// Only npm's purl grammar defines a namespace (the "@scope" of a
+	// scoped package); every other type here keeps its whole identifier
+	// in Name, with any "/" it contains percent-encoded on output by
+	// String(), not split into a namespace. An OCI identifier like
+	// "domdomegg/airtable-mcp-server" is a single repository name that
+	// happens to contain a slash, not a namespace/name pair.
+	namespace, name := "", f.Identifier
+	if f.RegistryType == model.RegistryTypeNPM && strings.HasPrefix(f.Identifier, "@") {
+		if ns, n, ok := strings.Cut(f.Identifier, "/"); ok {
+			namespace, name = ns, n
+		}
+	}
+
+	p := &PackageURL{Type: typ, Namespace: namespace, Name: name, Version: f.Version}
+	if f.RegistryBaseURL != "" {
+		p.Qualifiers = map[string]string{"repository_url": f.RegistryBaseURL}
+	}
+	p.normalize()
+	return p, nil
+}
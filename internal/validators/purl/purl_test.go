@@ -0,0 +1,111 @@
+package purl_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/validators/purl"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// testVector mirrors one entry of testdata/test-suite-data.json, which
+// follows the same valid/invalid test-vector shape as the purl-spec's
+// own test suite so the fixture can eventually be kept in sync with it.
+type testVector struct {
+	Description   string            `json:"description"`
+	Purl          string            `json:"purl"`
+	IsInvalid     bool              `json:"is_invalid"`
+	Type          string            `json:"type"`
+	Namespace     string            `json:"namespace"`
+	Name          string            `json:"name"`
+	Version       string            `json:"version"`
+	Qualifiers    map[string]string `json:"qualifiers"`
+	Subpath       string            `json:"subpath"`
+	CanonicalPurl string            `json:"canonical_purl"`
+}
+
+func loadTestVectors(t *testing.T) []testVector {
+	t.Helper()
+	data, err := os.ReadFile("testdata/test-suite-data.json")
+	require.NoError(t, err)
+
+	var vectors []testVector
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	return vectors
+}
+
+func TestParse_TestVectors(t *testing.T) {
+	for _, v := range loadTestVectors(t) {
+		t.Run(v.Description, func(t *testing.T) {
+			p, err := purl.Parse(v.Purl)
+
+			if v.IsInvalid {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, v.Type, p.Type)
+			assert.Equal(t, v.Namespace, p.Namespace)
+			assert.Equal(t, v.Name, p.Name)
+			assert.Equal(t, v.Version, p.Version)
+			if v.Qualifiers != nil {
+				assert.Equal(t, v.Qualifiers, p.Qualifiers)
+			} else {
+				assert.Empty(t, p.Qualifiers)
+			}
+			assert.Equal(t, v.Subpath, p.Subpath)
+			assert.Equal(t, v.CanonicalPurl, p.String())
+		})
+	}
+}
+
+func TestPackageFields_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		purl string
+		want purl.PackageFields
+	}{
+		{
+			name: "npm scoped package",
+			purl: "pkg:npm/%40modelcontextprotocol/server-filesystem@2.1.0",
+			want: purl.PackageFields{RegistryType: model.RegistryTypeNPM, Identifier: "@modelcontextprotocol/server-filesystem", Version: "2.1.0"},
+		},
+		{
+			name: "oci package with repository_url",
+			purl: "pkg:oci/domdomegg%2Fairtable-mcp-server@1.7.2?repository_url=docker.io",
+			want: purl.PackageFields{RegistryType: model.RegistryTypeOCI, Identifier: "domdomegg/airtable-mcp-server", Version: "1.7.2", RegistryBaseURL: "docker.io"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := purl.Parse(tt.purl)
+			require.NoError(t, err)
+
+			fields, err := p.ToPackageFields()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, fields)
+
+			back, err := purl.FromPackageFields(fields)
+			require.NoError(t, err)
+			assert.Equal(t, tt.purl, back.String())
+		})
+	}
+}
+
+func TestToPackageFields_UnsupportedType(t *testing.T) {
+	p, err := purl.Parse("pkg:cargo/serde_json@1.0.0")
+	require.NoError(t, err)
+
+	_, err = p.ToPackageFields()
+	assert.ErrorIs(t, err, purl.ErrInvalidPurl)
+}
+
+func TestFromPackageFields_UnsupportedRegistryType(t *testing.T) {
+	_, err := purl.FromPackageFields(purl.PackageFields{RegistryType: "mcpb", Identifier: "https://example.com/x.mcpb"})
+	assert.ErrorIs(t, err, purl.ErrInvalidPurl)
+}
@@ -0,0 +1,68 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+func TestValidate_Purl(t *testing.T) {
+	baseServer := func(pkg model.Package) apiv0.ServerJSON {
+		return apiv0.ServerJSON{
+			Name:        "com.example/test-server",
+			Description: "A test server",
+			Version:     "1.0.0",
+			Packages:    []model.Package{pkg},
+		}
+	}
+
+	t.Run("purl alone fills in registry fields", func(t *testing.T) {
+		s := baseServer(model.Package{
+			Purl:      "pkg:npm/%40modelcontextprotocol/server-filesystem@2.1.0",
+			Transport: model.Transport{Type: "stdio"},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		require.NoError(t, err)
+		assert.Equal(t, model.RegistryTypeNPM, s.Packages[0].RegistryType)
+		assert.Equal(t, "@modelcontextprotocol/server-filesystem", s.Packages[0].Identifier)
+		assert.Equal(t, "2.1.0", s.Packages[0].Version)
+	})
+
+	t.Run("purl is normalized to its canonical form", func(t *testing.T) {
+		s := baseServer(model.Package{
+			Purl:      "pkg:pypi/Time_MCP.Pypi@1.0.1",
+			Transport: model.Transport{Type: "stdio"},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		require.NoError(t, err)
+		assert.Equal(t, "pkg:pypi/time-mcp.pypi@1.0.1", s.Packages[0].Purl)
+	})
+
+	t.Run("mismatched registryType and purl type is rejected", func(t *testing.T) {
+		s := baseServer(model.Package{
+			RegistryType: model.RegistryTypePyPI,
+			Purl:         "pkg:npm/foo@1.0.0",
+			Transport:    model.Transport{Type: "stdio"},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		assert.ErrorIs(t, err, validators.ErrPurlRegistryTypeMismatch)
+	})
+
+	t.Run("malformed purl is rejected", func(t *testing.T) {
+		s := baseServer(model.Package{
+			Purl:      "not-a-purl",
+			Transport: model.Transport{Type: "stdio"},
+		})
+
+		err := validators.ValidateServerJSON(&s)
+		assert.ErrorIs(t, err, validators.ErrInvalidPurl)
+	})
+}
@@ -0,0 +1,239 @@
+// Package repohost normalizes repository URLs for the hosting providers
+// the registry understands, following the same "deduce the canonical
+// repo from whatever shape the user pasted" approach as hosted-git-info:
+// each provider declares the hosts it owns and the shape of the
+// owner/repo[/subpath] portion of the URL, and the package normalizes
+// HTTPS, SSH, git://, and shorthand ("owner/repo") inputs down to a
+// canonical HTTPS URL plus a stable ID.
+package repohost
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ErrUnknownProvider is returned when no registered provider recognizes
+// the given source/URL combination.
+var ErrUnknownProvider = errors.New("no repository provider recognizes this source and url")
+
+// ErrInvalidSubfolderPath is returned when a repository's Subfolder field
+// is not a clean, relative slash-separated path.
+var ErrInvalidSubfolderPath = errors.New("subfolder must be a relative path with no leading/trailing slash, empty segments, or path traversal")
+
+var subfolderSegment = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// ValidateSubfolder enforces the shared subfolder shape rules used by
+// providers with no override: relative, clean, no traversal, no empty
+// segments. Providers whose path shape differs register their own
+// Provider.ValidateSubfolder instead; use ValidateSubfolderForSource to
+// pick the right one for a given Repository.Source.
+func ValidateSubfolder(subfolder string) error {
+	return validateSubfolderSegments(subfolder, subfolderSegment)
+}
+
+// ValidateSubfolderForSource validates subfolder using the provider
+// registered for source, falling back to the shared ValidateSubfolder
+// rules if source has no registered provider (e.g. the generic git
+// fallback) or the provider doesn't override subfolder validation.
+func ValidateSubfolderForSource(source, subfolder string) error {
+	if p, ok := Lookup(source); ok && p.ValidateSubfolder != nil {
+		return p.ValidateSubfolder(subfolder)
+	}
+	return ValidateSubfolder(subfolder)
+}
+
+func validateSubfolderSegments(subfolder string, segmentPattern *regexp.Regexp) error {
+	if subfolder == "" {
+		return nil
+	}
+	if strings.HasPrefix(subfolder, "/") || strings.HasSuffix(subfolder, "/") {
+		return ErrInvalidSubfolderPath
+	}
+	for _, seg := range strings.Split(subfolder, "/") {
+		if seg == "" || seg == "." || seg == ".." {
+			return ErrInvalidSubfolderPath
+		}
+		if !segmentPattern.MatchString(seg) {
+			return ErrInvalidSubfolderPath
+		}
+	}
+	return nil
+}
+
+// Provider describes one repository hosting backend.
+type Provider struct {
+	// Name is the value expected in Repository.Source (e.g. "github").
+	Name string
+	// Hosts are the canonical hostnames this provider owns. The first
+	// entry is used when normalizing shorthand "owner/repo" references.
+	Hosts []string
+	// ParseOwnerRepo extracts the provider-specific id (and, for
+	// providers like Azure DevOps whose path shape differs from
+	// owner/repo, whatever identifier the provider considers stable)
+	// from a URL path with the leading slash already stripped. It
+	// returns ok=false if path doesn't match this provider's shape.
+	ParseOwnerRepo func(path string) (id string, ok bool)
+	// ValidateSubfolder checks a repository's Subfolder field against
+	// this provider's own path shape, overriding the shared
+	// ValidateSubfolder rules. Nil means the provider has no shape of
+	// its own and the shared rules apply as-is.
+	ValidateSubfolder func(subfolder string) error
+}
+
+var (
+	mu        sync.RWMutex
+	providers = map[string]*Provider{}
+)
+
+// Register adds or replaces a provider in the process-wide registry, so
+// operators can support additional hosts (private GitLab/Gitea
+// instances, internal mirrors) without forking the validator.
+func Register(p *Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[p.Name] = p
+}
+
+// Lookup returns the provider registered under the given Repository.Source
+// name.
+func Lookup(source string) (*Provider, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[source]
+	return p, ok
+}
+
+func init() {
+	Register(&Provider{Name: "github", Hosts: []string{"github.com"}, ParseOwnerRepo: ownerRepoParser(2)})
+	Register(&Provider{Name: "gitlab", Hosts: []string{"gitlab.com"}, ParseOwnerRepo: ownerRepoParser(2)})
+	Register(&Provider{Name: "bitbucket", Hosts: []string{"bitbucket.org"}, ParseOwnerRepo: ownerRepoParser(2)})
+	Register(&Provider{Name: "codeberg", Hosts: []string{"codeberg.org"}, ParseOwnerRepo: ownerRepoParser(2)})
+	Register(&Provider{Name: "sourcehut", Hosts: []string{"git.sr.ht"}, ParseOwnerRepo: sourcehutParser})
+	Register(&Provider{Name: "azuredevops", Hosts: []string{"dev.azure.com"}, ParseOwnerRepo: azureDevOpsParser, ValidateSubfolder: validateAzureDevOpsSubfolder})
+}
+
+// RegisterGiteaInstance registers a Gitea provider for a given self-hosted
+// instance host, since Gitea (unlike GitHub/GitLab) has no single
+// canonical domain; operators call this once per known instance
+// (gitea.example.com, codeberg-mirror.internal, ...).
+func RegisterGiteaInstance(host string) {
+	Register(&Provider{Name: "gitea:" + host, Hosts: []string{host}, ParseOwnerRepo: ownerRepoParser(2)})
+}
+
+// ownerRepoParser builds a ParseOwnerRepo for the common
+// "/owner/repo[/subpath]" shape, keeping only the first n path segments
+// (2 for owner/repo) as the stable ID.
+func ownerRepoParser(n int) func(string) (string, bool) {
+	return func(path string) (string, bool) {
+		path = strings.TrimSuffix(path, ".git")
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		if len(segments) < n {
+			return "", false
+		}
+		for _, s := range segments[:n] {
+			if s == "" {
+				return "", false
+			}
+		}
+		return strings.Join(segments[:n], "/"), true
+	}
+}
+
+// sourcehutParser handles git.sr.ht's "~user/repo" shape.
+func sourcehutParser(path string) (string, bool) {
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	segments := strings.Split(path, "/")
+	if len(segments) < 2 || !strings.HasPrefix(segments[0], "~") || segments[0] == "~" || segments[1] == "" {
+		return "", false
+	}
+	return strings.Join(segments[:2], "/"), true
+}
+
+// azureDevOpsParser handles dev.azure.com's "/org/project/_git/repo"
+// shape, which is a different number of segments than owner/repo.
+func azureDevOpsParser(path string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) != 4 || segments[2] != "_git" {
+		return "", false
+	}
+	for _, s := range []string{segments[0], segments[1], segments[3]} {
+		if s == "" {
+			return "", false
+		}
+	}
+	return strings.Join(segments, "/"), true
+}
+
+var azureDevOpsSubfolderSegment = regexp.MustCompile(`^[A-Za-z0-9._ -]+$`)
+
+// validateAzureDevOpsSubfolder is Azure DevOps's subfolder shape check:
+// the same relative/clean/no-traversal rules as the shared default, but
+// Azure Repos paths routinely contain spaces (e.g. "Shared Documents"),
+// which the shared segment pattern rejects.
+func validateAzureDevOpsSubfolder(subfolder string) error {
+	return validateSubfolderSegments(subfolder, azureDevOpsSubfolderSegment)
+}
+
+var scpLikeSSH = regexp.MustCompile(`^(?:[\w.-]+@)?([\w.-]+):(.+)$`)
+
+// splitHostPath deduces the (host, path) pair out of whatever shape the
+// repository URL was given in: HTTPS/HTTP, ssh://, git://, scp-like
+// git@host:owner/repo, or a bare owner/repo shorthand (host is "" in the
+// shorthand case; callers resolve it against the provider's default
+// host).
+func splitHostPath(raw string) (host, path string, ok bool) {
+	switch {
+	case strings.HasPrefix(raw, "https://"), strings.HasPrefix(raw, "http://"),
+		strings.HasPrefix(raw, "ssh://"), strings.HasPrefix(raw, "git://"):
+		u, err := url.Parse(raw)
+		if err != nil || u.Host == "" {
+			return "", "", false
+		}
+		return u.Hostname(), u.Path, true
+	case scpLikeSSH.MatchString(raw):
+		m := scpLikeSSH.FindStringSubmatch(raw)
+		return m[1], "/" + m[2], true
+	case !strings.Contains(raw, "://") && strings.Count(raw, "/") == 1 && !strings.Contains(raw, " "):
+		return "", "/" + raw, true
+	default:
+		return "", "", false
+	}
+}
+
+// Normalize resolves a repository URL against the provider registered
+// for source, returning a canonical HTTPS URL and a stable Repository.ID.
+func Normalize(source, rawURL string) (canonicalURL, id string, err error) {
+	p, ok := Lookup(source)
+	if !ok {
+		return "", "", fmt.Errorf("%w: %q", ErrUnknownProvider, source)
+	}
+
+	host, path, ok := splitHostPath(rawURL)
+	if !ok {
+		return "", "", fmt.Errorf("invalid repository url for %s: %q", source, rawURL)
+	}
+	if host == "" {
+		host = p.Hosts[0]
+	} else if !hostMatches(p, host) {
+		return "", "", fmt.Errorf("invalid repository url for %s: host %q is not a recognized %s host", source, host, source)
+	}
+
+	repoID, ok := p.ParseOwnerRepo(path)
+	if !ok {
+		return "", "", fmt.Errorf("invalid repository url for %s: %q", source, rawURL)
+	}
+	return fmt.Sprintf("https://%s/%s", host, repoID), repoID, nil
+}
+
+func hostMatches(p *Provider, host string) bool {
+	for _, h := range p.Hosts {
+		if strings.EqualFold(h, host) {
+			return true
+		}
+	}
+	return false
+}
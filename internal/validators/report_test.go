@@ -0,0 +1,90 @@
+package validators_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/validators"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+func invalidServer() apiv0.ServerJSON {
+	return apiv0.ServerJSON{
+		Name:        "no-slash-name",
+		Description: "A test server",
+		Version:     "^1.0.0",
+		Packages: []model.Package{
+			{
+				RegistryType: model.RegistryTypeNPM,
+				Identifier:   "has a space",
+				Version:      "1.0.0",
+				Transport:    model.Transport{Type: model.TransportTypeStdio},
+			},
+		},
+	}
+}
+
+func TestValidateServerJSONReport_CollectsEveryDiagnostic(t *testing.T) {
+	s := invalidServer()
+
+	report, err := validators.ValidateServerJSONReport(&s, false)
+	require.NoError(t, err)
+	require.True(t, report.HasErrors())
+
+	// Both the top-level name/version problems and the nested package
+	// problem should be present, not just the first one found.
+	assert.GreaterOrEqual(t, len(report.Diagnostics), 2)
+
+	var sawVersionRange, sawPackageSpaces bool
+	for _, d := range report.Diagnostics {
+		assert.Equal(t, validators.SeverityError, d.Severity)
+		assert.NotEmpty(t, d.Path)
+		switch d.Code {
+		case "version.range":
+			sawVersionRange = true
+			assert.Equal(t, "/version", d.Path)
+		case "package.identifier.spaces":
+			sawPackageSpaces = true
+			assert.Equal(t, "/packages/0", d.Path)
+		}
+	}
+	assert.True(t, sawVersionRange, "expected a version.range diagnostic")
+	assert.True(t, sawPackageSpaces, "expected a package.identifier.spaces diagnostic")
+}
+
+func TestValidateServerJSONReport_StopOnFirst(t *testing.T) {
+	s := invalidServer()
+
+	report, err := validators.ValidateServerJSONReport(&s, true)
+	require.NoError(t, err)
+	require.Len(t, report.Diagnostics, 1)
+}
+
+func TestValidateServerJSONReport_MatchesValidateServerJSON(t *testing.T) {
+	s := invalidServer()
+
+	legacyErr := validators.ValidateServerJSON(&s)
+	require.Error(t, legacyErr)
+
+	var legacyReport validators.ValidationErrors
+	require.ErrorAs(t, legacyErr, &legacyReport)
+
+	report, err := validators.ValidateServerJSONReport(&s, true)
+	require.NoError(t, err)
+	require.True(t, report.HasErrors())
+	assert.Equal(t, legacyReport, report)
+}
+
+func TestReport_ToProblemDetails(t *testing.T) {
+	s := invalidServer()
+	report, err := validators.ValidateServerJSONReport(&s, false)
+	require.NoError(t, err)
+
+	problem := report.ToProblemDetails()
+	assert.Equal(t, validators.ProblemDetailsType, problem.Type)
+	assert.Equal(t, 422, problem.Status)
+	assert.Equal(t, report.Diagnostics, problem.Diagnostics)
+}
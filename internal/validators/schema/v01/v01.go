@@ -0,0 +1,515 @@
+// Package v01 implements validation for the "v0.1" ServerJSON schema.
+// It is the oldest schema version the registry understands; new schema
+// versions get their own sibling sub-package plus a Translate function
+// that upgrades a v0.1 document into the newer shape, so that
+// internal/validators can walk the chain in internal/validators.go's
+// MigrateToLatest.
+package v01
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/modelcontextprotocol/registry/internal/registries"
+	"github.com/modelcontextprotocol/registry/internal/validators/diag"
+	"github.com/modelcontextprotocol/registry/internal/validators/pkgspec"
+	"github.com/modelcontextprotocol/registry/internal/validators/purl"
+	"github.com/modelcontextprotocol/registry/internal/validators/repohost"
+	"github.com/modelcontextprotocol/registry/internal/validators/verr"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+	"github.com/modelcontextprotocol/registry/pkg/model"
+)
+
+// Version is the $schemaVersion string this package validates.
+const Version = "v0.1"
+
+// Options carries the runtime knobs that affect validation but aren't
+// part of the document itself.
+type Options struct {
+	// GenericGitFallback allows a repository whose Source isn't a
+	// registered provider, as long as its URL is HTTPS and ends in an
+	// owner/repo-shaped path.
+	GenericGitFallback bool
+
+	// StopOnFirst makes ValidateReport return as soon as the first
+	// diagnostic is recorded, instead of collecting every problem in the
+	// document. Validate always behaves as if this were true.
+	StopOnFirst bool
+}
+
+// Validate checks that a v0.1 ServerJSON document is well-formed: server
+// name shape, pinned (non-range) versions, repository URL and subfolder,
+// website URL namespace match, package identifiers, and transport
+// configuration. It does not perform any network calls, and stops at the
+// first problem found; see ValidateReport for the full diagnostic list.
+func Validate(s *apiv0.ServerJSON, opts Options) error {
+	opts.StopOnFirst = true
+	return ValidateReport(s, opts).Err()
+}
+
+// ValidateReport runs the same checks as Validate but, unless
+// opts.StopOnFirst is set, keeps going after a failure so a publisher
+// sees every problem in one submission. Each diagnostic carries a
+// Path to the offending value in the submitted document.
+func ValidateReport(s *apiv0.ServerJSON, opts Options) diag.Report {
+	var report diag.Report
+
+	// record appends a diagnostic and reports whether the caller should
+	// stop walking further checks.
+	record := func(d diag.Diagnostic) bool {
+		report.Add(d)
+		return opts.StopOnFirst
+	}
+
+	if err := validateServerName(s.Name); err != nil {
+		if record(diag.NewError(diagnosticCode(err), "/name", err)) {
+			return report
+		}
+	}
+	if looksLikeVersionRange(s.Version) {
+		if record(diag.NewError(diagnosticCode(verr.ErrVersionLooksLikeRange), "/version", verr.ErrVersionLooksLikeRange)) {
+			return report
+		}
+	}
+	if s.Repository.URL != "" || s.Repository.Source != "" {
+		if err := validateRepository(&s.Repository, opts); err != nil {
+			if record(diag.NewError(diagnosticCode(err), "/repository", err)) {
+				return report
+			}
+		}
+	}
+	if err := validateWebsiteURL(s); err != nil {
+		if record(diag.NewError(diagnosticCode(err), "/websiteUrl", err)) {
+			return report
+		}
+	}
+	for i := range s.Packages {
+		if err := validatePackage(&s.Packages[i], s.Repository.URL); err != nil {
+			if record(diag.NewError(diagnosticCode(err), fmt.Sprintf("/packages/%d", i), err)) {
+				return report
+			}
+		}
+	}
+	for i := range s.Remotes {
+		if err := validateRemote(&s.Remotes[i], s.Name); err != nil {
+			if record(diag.NewError(diagnosticCode(err), fmt.Sprintf("/remotes/%d", i), err)) {
+				return report
+			}
+		}
+	}
+	return report
+}
+
+// diagnosticCode maps an error produced by this package's validation
+// helpers to a stable, dotted Diagnostic code. Errors that aren't one of
+// the known sentinels (e.g. an fmt.Errorf with no wrapped sentinel) fall
+// back to the generic code "validation.error".
+func diagnosticCode(err error) string {
+	switch {
+	case errors.Is(err, verr.ErrVersionLooksLikeRange):
+		return "version.range"
+	case errors.Is(err, verr.ErrReservedVersionString):
+		return "version.reserved"
+	case errors.Is(err, verr.ErrMultipleSlashesInServerName):
+		return "server.name.multiple_slashes"
+	case errors.Is(err, verr.ErrInvalidRepositoryURL):
+		return "repository.url"
+	case errors.Is(err, verr.ErrInvalidSubfolderPath):
+		return "repository.subfolder"
+	case errors.Is(err, verr.ErrPackageNameHasSpaces):
+		return "package.identifier.spaces"
+	case errors.Is(err, verr.ErrInvalidRemoteURL):
+		return "remote.url"
+	case errors.Is(err, verr.ErrPurlRegistryTypeMismatch):
+		return "purl.registry_type_mismatch"
+	case errors.Is(err, verr.ErrInvalidPurl):
+		return "purl.invalid"
+	case errors.Is(err, verr.ErrProvenanceSubjectDigestMismatch):
+		return "provenance.subject_digest_mismatch"
+	case errors.Is(err, verr.ErrProvenanceSourceMismatch):
+		return "provenance.source_mismatch"
+	case errors.Is(err, verr.ErrUntrustedBuilderIdentity):
+		return "provenance.untrusted_builder"
+	case errors.Is(err, verr.ErrTransparencyLogEntryNotFound):
+		return "provenance.transparency_log_missing"
+	case errors.Is(err, verr.ErrTransportSchemeMismatch):
+		return "transport.url.scheme_mismatch"
+	case errors.Is(err, verr.ErrInvalidSubprotocol):
+		return "transport.subprotocol.invalid"
+	default:
+		return "validation.error"
+	}
+}
+
+var (
+	versionOperatorPattern    = regexp.MustCompile(`^\s*(\^|~|>=|<=|>|<|=)`)
+	versionOrRangePattern     = regexp.MustCompile(`\|\|`)
+	versionHyphenRangePattern = regexp.MustCompile(`^\s*v?\d[\w.+-]*\s+-\s+v?\d[\w.+-]*\s*$`)
+	versionWildcardPattern    = regexp.MustCompile(`(^|\.)[xX*](\.|$)`)
+)
+
+// looksLikeVersionRange reports whether v is a semver range expression
+// (caret/tilde, comparator, wildcard, hyphen range, or OR range) rather
+// than a single pinned version. Freeform non-semver version strings
+// (dates, "snapshot", etc.) are intentionally left alone.
+func looksLikeVersionRange(v string) bool {
+	if v == "" {
+		return false
+	}
+	switch {
+	case versionOrRangePattern.MatchString(v),
+		versionHyphenRangePattern.MatchString(v),
+		versionOperatorPattern.MatchString(v),
+		versionWildcardPattern.MatchString(v):
+		return true
+	default:
+		return false
+	}
+}
+
+func validateServerName(name string) error {
+	if name == "" {
+		return fmt.Errorf("server name is required")
+	}
+	switch strings.Count(name, "/") {
+	case 0:
+		return fmt.Errorf("server name must be in format 'dns-namespace/name', got %q", name)
+	case 1:
+		namespace, rest, _ := strings.Cut(name, "/")
+		if namespace == "" || rest == "" {
+			return fmt.Errorf("server name must have non-empty namespace and name parts: %q", name)
+		}
+		return nil
+	default:
+		return verr.ErrMultipleSlashesInServerName
+	}
+}
+
+// namespaceDomain turns a reverse-DNS namespace ("com.example") into the
+// domain a publisher's websiteUrl/remote host is expected to live under
+// ("example.com").
+func namespaceDomain(name string) string {
+	namespace, _, _ := strings.Cut(name, "/")
+	labels := strings.Split(namespace, ".")
+	for i, j := 0, len(labels)-1; i < j; i, j = i+1, j-1 {
+		labels[i], labels[j] = labels[j], labels[i]
+	}
+	return strings.Join(labels, ".")
+}
+
+func validateWebsiteURL(s *apiv0.ServerJSON) error {
+	if s.WebsiteURL == "" {
+		return nil
+	}
+	u, err := url.Parse(s.WebsiteURL)
+	if err != nil {
+		return fmt.Errorf("invalid websiteUrl: %w", err)
+	}
+	if u.Scheme == "" {
+		return fmt.Errorf("websiteUrl must be absolute (include scheme): %s", s.WebsiteURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("websiteUrl must use http or https scheme: %s", s.WebsiteURL)
+	}
+	domain := namespaceDomain(s.Name)
+	if domain != "" && u.Hostname() != domain && !strings.HasSuffix(u.Hostname(), "."+domain) {
+		return fmt.Errorf("websiteUrl %s does not match namespace %s", s.WebsiteURL, s.Name)
+	}
+	return nil
+}
+
+func validateRepository(repo *model.Repository, opts Options) error {
+	if err := repohost.ValidateSubfolderForSource(repo.Source, repo.Subfolder); err != nil {
+		return verr.ErrInvalidSubfolderPath
+	}
+
+	canonicalURL, id, err := repohost.Normalize(repo.Source, repo.URL)
+	if err != nil && opts.GenericGitFallback {
+		canonicalURL, id, err = genericNormalize(repo.URL)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", verr.ErrInvalidRepositoryURL, err)
+	}
+	if repo.ID == "" {
+		repo.ID = id
+	}
+	_ = canonicalURL // reserved for callers that want the canonical form
+	return nil
+}
+
+// genericNormalize implements the "generic git" fallback provider: any
+// HTTPS URL whose path is exactly two segments (owner/repo-shaped) is
+// accepted, regardless of host.
+func genericNormalize(rawURL string) (canonicalURL, id string, err error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return "", "", fmt.Errorf("generic git fallback requires an https url, got %q", rawURL)
+	}
+	path := strings.TrimSuffix(strings.Trim(u.Path, "/"), ".git")
+	segments := strings.Split(path, "/")
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("generic git fallback requires an owner/repo path, got %q", rawURL)
+	}
+	return fmt.Sprintf("https://%s/%s", u.Host, path), path, nil
+}
+
+// reconcilePurl validates pkg.Purl (if set), fills in any of
+// RegistryType/Identifier/Version/RegistryBaseURL that were left blank
+// from it, rejects a RegistryType that disagrees with the purl's type,
+// and rewrites Purl to its canonical form.
+func reconcilePurl(pkg *model.Package) error {
+	if pkg.Purl == "" {
+		return nil
+	}
+
+	parsed, err := purl.Parse(pkg.Purl)
+	if err != nil {
+		return fmt.Errorf("%w: %w", verr.ErrInvalidPurl, err)
+	}
+	fields, err := parsed.ToPackageFields()
+	if err != nil {
+		return fmt.Errorf("%w: %w", verr.ErrInvalidPurl, err)
+	}
+
+	if pkg.RegistryType != "" && pkg.RegistryType != fields.RegistryType {
+		return fmt.Errorf("%w: purl type %q, registryType %q", verr.ErrPurlRegistryTypeMismatch, parsed.Type, pkg.RegistryType)
+	}
+
+	if pkg.RegistryType == "" {
+		pkg.RegistryType = fields.RegistryType
+	}
+	if pkg.Identifier == "" {
+		pkg.Identifier = fields.Identifier
+	}
+	if pkg.Version == "" {
+		pkg.Version = fields.Version
+	}
+	if pkg.RegistryBaseURL == "" {
+		pkg.RegistryBaseURL = fields.RegistryBaseURL
+	}
+	pkg.Purl = parsed.String()
+	return nil
+}
+
+func validatePackage(pkg *model.Package, repositoryURL string) error {
+	if err := reconcilePurl(pkg); err != nil {
+		return err
+	}
+	if strings.Contains(pkg.Identifier, " ") {
+		return verr.ErrPackageNameHasSpaces
+	}
+	if pkgspec.ReservedVersionError(pkg.RegistryType, pkg.Version) != nil {
+		return verr.ErrReservedVersionString
+	}
+	if looksLikeVersionRange(pkg.Version) {
+		return verr.ErrVersionLooksLikeRange
+	}
+	if err := validateRegistryType(pkg); err != nil {
+		return err
+	}
+	if err := validateProvenance(pkg, repositoryURL); err != nil {
+		return err
+	}
+	for _, args := range [][]model.Argument{pkg.RuntimeArguments, pkg.PackageArguments} {
+		for i := range args {
+			if err := validateArgument(&args[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return validateTransport(&pkg.Transport, true, declaredVariables(pkg))
+}
+
+// namedArgumentNamePattern matches the flag-shaped names a named
+// argument's Name is expected to hold (e.g. "--directory", "-v",
+// "output-format"). It exists to reject names that actually embed a
+// description, an example value, or a `$VARIABLE` reference - content
+// that belongs in Description/Value/Default instead - which installers
+// copying Name verbatim onto a command line would otherwise mangle.
+var namedArgumentNamePattern = regexp.MustCompile(`^[^\s<>$]+$`)
+
+// validateArgument checks the shape of a single runtime or package
+// argument. Positional arguments carry no flag name, so Name and Value
+// are free-form there (e.g. "anything with spaces" is a legitimate
+// positional value); only named arguments are checked.
+func validateArgument(arg *model.Argument) error {
+	if arg.Type != model.ArgumentTypeNamed {
+		return nil
+	}
+	if !namedArgumentNamePattern.MatchString(arg.Name) {
+		return fmt.Errorf("%w: %q", verr.ErrInvalidNamedArgumentName, arg.Name)
+	}
+	for _, value := range []string{arg.Input.Value, arg.Input.Default} {
+		if value != "" && strings.HasPrefix(value, arg.Name) {
+			return fmt.Errorf("%w: %q repeats name %q", verr.ErrRedundantArgumentValue, value, arg.Name)
+		}
+	}
+	return nil
+}
+
+// validateProvenance checks the structural/content fields of a
+// package's Provenance statement: that it carries an issuer and builder
+// identity, that its subject digest agrees with FileSHA256, and that its
+// source repository matches the server's declared repository. It does
+// not verify the statement's signature. When EnableRegistryValidation is
+// set, internal/validators/provenance fetches the statement from the
+// issuing registry and repeats content checks on it; its signature is
+// checked only by a SignatureVerifier installed with
+// provenance.RegisterSignatureVerifier (see ValidatePublishRequest).
+func validateProvenance(pkg *model.Package, repositoryURL string) error {
+	p := pkg.Provenance
+	if p == nil {
+		return nil
+	}
+	if p.Issuer == "" || p.BuilderID == "" {
+		return fmt.Errorf("provenance requires an issuer and builderId")
+	}
+	if pkg.FileSHA256 != "" && p.SubjectDigest != "" {
+		digest := strings.TrimPrefix(p.SubjectDigest, "sha256:")
+		if digest != pkg.FileSHA256 {
+			return fmt.Errorf("%w: statement digest %s, package fileSha256 %s", verr.ErrProvenanceSubjectDigestMismatch, digest, pkg.FileSHA256)
+		}
+	}
+	if p.SourceRepoURI != "" && repositoryURL != "" && !strings.EqualFold(strings.TrimSuffix(p.SourceRepoURI, ".git"), strings.TrimSuffix(repositoryURL, ".git")) {
+		return fmt.Errorf("%w: statement source %s, repository %s", verr.ErrProvenanceSourceMismatch, p.SourceRepoURI, repositoryURL)
+	}
+	return nil
+}
+
+// validateRegistryType checks that Package.RegistryType has a registered
+// internal/registries.Backend and that RegistryBaseURL (if set) agrees
+// with it, then delegates identifier validation to that backend. Types
+// without a registered backend (third-party ecosystems that haven't
+// called validators.RegisterBackend) are rejected.
+func validateRegistryType(pkg *model.Package) error {
+	backend, ok := registries.Lookup(pkg.RegistryType)
+	if !ok {
+		return fmt.Errorf("unsupported registry type: %q", pkg.RegistryType)
+	}
+	if expected := backend.DefaultBaseURL(); expected != "" && pkg.RegistryBaseURL != "" && pkg.RegistryBaseURL != expected {
+		return fmt.Errorf("registry base url %s does not match expected %s for registry type %s", pkg.RegistryBaseURL, expected, pkg.RegistryType)
+	}
+	parsed, err := backend.ValidateIdentifier(*pkg)
+	if err != nil {
+		return err
+	}
+	pkg.ParsedIdentifier = parsed
+	return nil
+}
+
+// declaredVariables collects the variable names a package makes
+// available for `{variable}` substitution in a transport URL: every
+// environment variable name, plus any named variables attached to
+// runtime/package arguments.
+func declaredVariables(pkg *model.Package) map[string]bool {
+	declared := make(map[string]bool)
+	for _, ev := range pkg.EnvironmentVariables {
+		declared[ev.Name] = true
+	}
+	for _, args := range [][]model.Argument{pkg.RuntimeArguments, pkg.PackageArguments} {
+		for _, arg := range args {
+			for name := range arg.Variables {
+				declared[name] = true
+			}
+		}
+	}
+	return declared
+}
+
+func validateRemote(remote *model.Transport, serverName string) error {
+	if err := validateTransport(remote, false, nil); err != nil {
+		return err
+	}
+	u, err := url.Parse(remote.URL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("%w: %s", verr.ErrInvalidRemoteURL, remote.URL)
+	}
+	if isLocalhost(u.Hostname()) {
+		return fmt.Errorf("%w: localhost is not allowed for remotes: %s", verr.ErrInvalidRemoteURL, remote.URL)
+	}
+	domain := namespaceDomain(serverName)
+	if domain != "" && u.Hostname() != domain && !strings.HasSuffix(u.Hostname(), "."+domain) {
+		return fmt.Errorf("remote URL host %s does not match publisher domain %s", u.Hostname(), domain)
+	}
+	return nil
+}
+
+func isLocalhost(host string) bool {
+	return host == "localhost" || host == "127.0.0.1" || host == "::1"
+}
+
+var templateVariablePattern = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// transportURLSchemes maps a transport type to the URL scheme its URL
+// must use. Types not listed here (stdio, streamable-http, sse) are not
+// scheme-checked.
+var transportURLSchemes = map[string]string{
+	model.TransportTypeWS:  "ws",
+	model.TransportTypeWSS: "wss",
+}
+
+// subprotocolPattern matches a single HTTP token (RFC 7230 section 3.2.6),
+// the grammar RFC 6455 uses for Sec-WebSocket-Protocol values and the one
+// IANA's WebSocket Subprotocol Name Registry requires of registered names.
+var subprotocolPattern = regexp.MustCompile(`^[!#$%&'*+\-.^_` + "`" + `|~0-9A-Za-z]+$`)
+
+// validateTransport checks that a package or remote transport's URL is
+// present/absent as required by its type, and that any templated
+// `{variable}` placeholders in the URL are declared. isPackage
+// distinguishes which transport types are supported for packages
+// (stdio allowed) vs. remotes (stdio not allowed). declared is nil for
+// remotes, which don't carry variable declarations.
+func validateTransport(t *model.Transport, isPackage bool, declared map[string]bool) error {
+	supported := map[string]bool{
+		model.TransportTypeStreamableHTTP: true,
+		model.TransportTypeSSE:            true,
+		model.TransportTypeWS:             true,
+		model.TransportTypeWSS:            true,
+	}
+	if isPackage {
+		supported[model.TransportTypeStdio] = true
+	}
+	if !supported[t.Type] {
+		if isPackage {
+			return fmt.Errorf("unsupported transport type: %s", t.Type)
+		}
+		return fmt.Errorf("unsupported transport type for remotes: %s", t.Type)
+	}
+
+	if t.Type == model.TransportTypeStdio {
+		if t.URL != "" {
+			return fmt.Errorf("url must be empty for stdio transport type")
+		}
+		return nil
+	}
+
+	if t.URL == "" {
+		return fmt.Errorf("url is required for %s transport type", t.Type)
+	}
+
+	if wantScheme, ok := transportURLSchemes[t.Type]; ok {
+		// Checked against the literal prefix rather than parsed with
+		// url.Parse: the URL may still carry unexpanded `{variable}`
+		// placeholders (e.g. "wss://{host}/mcp"), and net/url rejects
+		// "{"/"}" in a host.
+		if !strings.HasPrefix(t.URL, wantScheme+"://") {
+			return fmt.Errorf("%w: %s transport requires a %s:// url, got %s", verr.ErrTransportSchemeMismatch, t.Type, wantScheme, t.URL)
+		}
+	}
+
+	for _, m := range templateVariablePattern.FindAllStringSubmatch(t.URL, -1) {
+		if !declared[m[1]] {
+			return fmt.Errorf("template variables in URL are not declared: %s", m[1])
+		}
+	}
+
+	for _, sp := range t.Subprotocols {
+		if !subprotocolPattern.MatchString(sp) {
+			return fmt.Errorf("%w: %q", verr.ErrInvalidSubprotocol, sp)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// Package snapshot implements an on-disk, air-gapped-friendly cache of
+// package registry metadata, following the same leaf-package shape as
+// datasource and provenance. A bundle is a gzipped tarball containing one
+// {registryType}/{identifier}/{version}.json file per captured package
+// plus a manifest.json mapping each file to the SHA256 of its contents.
+// Build produces a bundle from a list of Entry values; Open loads one
+// into a Store, so ValidatePublishRequest can resolve package existence
+// and expected digests without reaching npmjs.org, PyPI or GHCR — the
+// pattern Nix derivations and Bazel use for hermetic builds.
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+)
+
+// ErrNotCaptured is returned when a package identifier/version (or its
+// digest) wasn't captured in the snapshot bundle.
+var ErrNotCaptured = errors.New("snapshot: package not captured in bundle")
+
+// Entry is one package captured into a snapshot bundle by Build.
+type Entry struct {
+	RegistryType string
+	Identifier   string
+	Version      string
+
+	// SHA256 is the expected artifact digest for this package, if known.
+	// Leave blank for registry types that don't carry one (e.g. FileSHA256
+	// wasn't set on the source ServerJSON).
+	SHA256 string
+}
+
+// Store resolves package existence and artifact digests against a
+// pre-built snapshot bundle instead of the network. It is the interface
+// ValidatePublishRequest consults when
+// config.RegistryValidationModeSnapshot is selected.
+type Store interface {
+	// Exists reports whether identifier@version was captured for
+	// registryType.
+	Exists(registryType, identifier, version string) (bool, error)
+
+	// SHA256 returns the expected artifact digest captured for
+	// identifier@version. It returns ErrNotCaptured if no digest was
+	// recorded for that entry.
+	SHA256(registryType, identifier, version string) (string, error)
+}
+
+// entryRecord is the JSON shape of a single {version}.json file inside
+// the bundle.
+type entryRecord struct {
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+const manifestName = "manifest.json"
+
+func entryPath(registryType, identifier, version string) string {
+	return path.Join(registryType, identifier, version+".json")
+}
+
+// Build writes a gzipped tar bundle of entries to w: one JSON file per
+// entry at {registryType}/{identifier}/{version}.json, plus a
+// manifest.json mapping each entry's path to the SHA256 of its contents,
+// so Open can detect a truncated or tampered bundle before trusting
+// anything it contains.
+func Build(w io.Writer, entries []Entry) error {
+	manifest := make(map[string]string, len(entries))
+	type file struct {
+		name string
+		data []byte
+	}
+	files := make([]file, 0, len(entries)+1)
+
+	for _, e := range entries {
+		data, err := json.Marshal(entryRecord{SHA256: e.SHA256})
+		if err != nil {
+			return fmt.Errorf("snapshot: marshal %s/%s@%s: %w", e.RegistryType, e.Identifier, e.Version, err)
+		}
+		name := entryPath(e.RegistryType, e.Identifier, e.Version)
+		sum := sha256.Sum256(data)
+		manifest[name] = hex.EncodeToString(sum[:])
+		files = append(files, file{name: name, data: data})
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("snapshot: marshal manifest: %w", err)
+	}
+	files = append(files, file{name: manifestName, data: manifestData})
+
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	for _, f := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0o644, Size: int64(len(f.data))}); err != nil {
+			return fmt.Errorf("snapshot: write header for %s: %w", f.name, err)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return fmt.Errorf("snapshot: write %s: %w", f.name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("snapshot: close tar: %w", err)
+	}
+	return gw.Close()
+}
+
+// memStore is the Store Open returns: a snapshot bundle is small
+// metadata with no artifact bytes, so it's fully decoded into memory
+// rather than re-read from the tarball on every lookup.
+type memStore struct {
+	entries map[string]entryRecord
+}
+
+// Open reads a gzipped tar bundle produced by Build from r and returns a
+// Store backed by its in-memory contents. Open fails closed if any entry
+// listed in the bundle's manifest.json is missing from the bundle or
+// doesn't match its recorded checksum, so a truncated or tampered bundle
+// is rejected at load time rather than silently validating against
+// partial data. Files in the bundle that the manifest doesn't list are
+// ignored and never loaded into the Store.
+func Open(r io.Reader) (Store, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: open gzip: %w", err)
+	}
+	tr := tar.NewReader(gr)
+
+	rawFiles := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: read tar: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, fmt.Errorf("snapshot: read %s: %w", hdr.Name, err)
+		}
+		rawFiles[hdr.Name] = data
+	}
+
+	manifestData, ok := rawFiles[manifestName]
+	if !ok {
+		return nil, errors.New("snapshot: bundle missing manifest.json")
+	}
+	var manifest map[string]string
+	if err := json.Unmarshal(manifestData, &manifest); err != nil {
+		return nil, fmt.Errorf("snapshot: parse manifest: %w", err)
+	}
+
+	entries := make(map[string]entryRecord, len(manifest))
+	for name, wantSum := range manifest {
+		data, ok := rawFiles[name]
+		if !ok {
+			return nil, fmt.Errorf("snapshot: manifest references missing entry %s", name)
+		}
+		sum := sha256.Sum256(data)
+		if hex.EncodeToString(sum[:]) != wantSum {
+			return nil, fmt.Errorf("snapshot: checksum mismatch for %s", name)
+		}
+		var rec entryRecord
+		if err := json.Unmarshal(data, &rec); err != nil {
+			return nil, fmt.Errorf("snapshot: parse entry %s: %w", name, err)
+		}
+		entries[name] = rec
+	}
+
+	return &memStore{entries: entries}, nil
+}
+
+func (s *memStore) Exists(registryType, identifier, version string) (bool, error) {
+	_, ok := s.entries[entryPath(registryType, identifier, version)]
+	return ok, nil
+}
+
+func (s *memStore) SHA256(registryType, identifier, version string) (string, error) {
+	e, ok := s.entries[entryPath(registryType, identifier, version)]
+	if !ok || e.SHA256 == "" {
+		return "", fmt.Errorf("%w: %s/%s@%s", ErrNotCaptured, registryType, identifier, version)
+	}
+	return e.SHA256, nil
+}
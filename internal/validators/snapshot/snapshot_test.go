@@ -0,0 +1,81 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeBundle builds a minimal gzipped tar bundle directly from raw file
+// contents, bypassing Build, so tests can construct bundles that Build
+// itself would never produce (e.g. a tampered entry or a missing
+// manifest).
+func writeBundle(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(data))}))
+		_, err := tw.Write(data)
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gw.Close())
+	return buf.Bytes()
+}
+
+func TestBuildOpen_RoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+	entries := []Entry{
+		{RegistryType: "npm", Identifier: "left-pad", Version: "1.3.0", SHA256: "abc123"},
+		{RegistryType: "pypi", Identifier: "requests", Version: "2.31.0"},
+	}
+	require.NoError(t, Build(&buf, entries))
+
+	store, err := Open(&buf)
+	require.NoError(t, err)
+
+	exists, err := store.Exists("npm", "left-pad", "1.3.0")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	sum, err := store.SHA256("npm", "left-pad", "1.3.0")
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", sum)
+
+	exists, err = store.Exists("pypi", "requests", "2.31.0")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	_, err = store.SHA256("pypi", "requests", "2.31.0")
+	assert.ErrorIs(t, err, ErrNotCaptured)
+
+	exists, err = store.Exists("npm", "left-pad", "9.9.9")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestOpen_TamperedEntryRejected(t *testing.T) {
+	entryJSON := []byte(`{"sha256":"abc123"}`)
+	bundle := writeBundle(t, map[string][]byte{
+		"npm/left-pad/1.3.0.json": entryJSON,
+		"manifest.json":           []byte(`{"npm/left-pad/1.3.0.json":"0000000000000000000000000000000000000000000000000000000000000000"}`),
+	})
+
+	_, err := Open(bytes.NewReader(bundle))
+	assert.Error(t, err)
+}
+
+func TestOpen_MissingManifest(t *testing.T) {
+	bundle := writeBundle(t, map[string][]byte{
+		"npm/left-pad/1.3.0.json": []byte(`{"sha256":"abc123"}`),
+	})
+
+	_, err := Open(bytes.NewReader(bundle))
+	assert.Error(t, err)
+}
@@ -0,0 +1,162 @@
+// Package validators enforces the shape and semantic rules that every
+// published ServerJSON document must satisfy before it is accepted by
+// the registry.
+//
+// Validation is dispatched on the document's $schemaVersion: each schema
+// version's rules live in its own sub-package under
+// internal/validators/schema, and this package is a thin router plus the
+// forward-migration chain (MigrateToLatest) that lets old publishes keep
+// working as the schema evolves.
+package validators
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/registry/internal/registries"
+	"github.com/modelcontextprotocol/registry/internal/validators/diag"
+	"github.com/modelcontextprotocol/registry/internal/validators/repohost"
+	"github.com/modelcontextprotocol/registry/internal/validators/schema/v01"
+	"github.com/modelcontextprotocol/registry/internal/validators/snapshot"
+	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
+)
+
+// RegistryBackend is re-exported from internal/registries so that
+// third-party backend implementations (and validators.RegisterBackend
+// callers) never need to import that package directly.
+type RegistryBackend = registries.Backend
+
+// SnapshotStore is re-exported from internal/validators/snapshot so that
+// callers building a config.Config in config.RegistryValidationModeSnapshot
+// mode never need to import that package directly.
+type SnapshotStore = snapshot.Store
+
+// Severity, Diagnostic and Report are re-exported from diag so that
+// callers never need to import the internal diag package directly; see
+// diag's doc comment for why the type lives one level down.
+//
+// ValidationError and ValidationErrors are the same two types under the
+// names publishing tooling (CI jobs, IDE plugins) should reach for: a
+// ValidationErrors is returned wherever ValidateServerJSON and
+// ValidatePublishRequest used to return a plain error, and itself
+// satisfies the error interface, so existing `if err != nil`/errors.Is
+// callers keep working unchanged while callers that want to pinpoint
+// exactly which field failed can type-assert to ValidationErrors and
+// read each ValidationError's Code, Path, Message and Details.
+type (
+	Severity         = diag.Severity
+	Diagnostic       = diag.Diagnostic
+	Report           = diag.Report
+	ValidationError  = diag.Diagnostic
+	ValidationErrors = diag.Report
+)
+
+const (
+	SeverityError   = diag.SeverityError
+	SeverityWarning = diag.SeverityWarning
+	SeverityInfo    = diag.SeverityInfo
+)
+
+// RegisterRepositoryProvider registers an additional repository hosting
+// provider (e.g. a private Gitea or GitLab instance) so that
+// Repository.Source values beyond the built-ins are accepted. It is the
+// public extension point wrapping the internal repohost registry.
+func RegisterRepositoryProvider(p *repohost.Provider) {
+	repohost.Register(p)
+}
+
+// RegisterBackend installs a RegistryBackend for a package ecosystem, so
+// operators can add support for registries (Maven, Cargo, RubyGems, the
+// Go module proxy, Hex, etc.) beyond the built-in npm/pypi/oci/nuget/mcpb
+// without editing core validator code. It is the public extension point
+// wrapping the internal registries registry.
+func RegisterBackend(backend RegistryBackend) {
+	registries.Register(backend)
+}
+
+// LatestSchemaVersion is the $schemaVersion new publishes should use
+// when none is specified.
+const LatestSchemaVersion = v01.Version
+
+// schemaReportValidator validates one version of the ServerJSON schema,
+// collecting every diagnostic found rather than stopping at the first.
+// Every version currently shares v01.Options; a future version whose
+// options differ would need its own dispatch branch instead of a shared
+// map.
+type schemaReportValidator func(s *apiv0.ServerJSON, opts v01.Options) Report
+
+var schemaReportValidators = map[string]schemaReportValidator{
+	"":          v01.ValidateReport, // unspecified $schemaVersion defaults to the oldest/only version
+	v01.Version: v01.ValidateReport,
+}
+
+// ValidateServerJSON checks that a ServerJSON document is well-formed
+// according to its declared (or default) $schemaVersion. It returns a
+// ValidationErrors (satisfying error) so callers that only check for
+// failure keep working unchanged, while callers that want to pinpoint
+// exactly which field failed can type-assert the returned error to
+// ValidationErrors and read each ValidationError's Code, Path and
+// Details. It stops at the first problem found; see
+// ValidateServerJSONReport for the full diagnostic list. It does not
+// perform any network calls; see ValidatePublishRequest for checks that
+// require reaching out to package registries.
+func ValidateServerJSON(s *apiv0.ServerJSON) error {
+	report, err := ValidateServerJSONReport(s, true)
+	if err != nil {
+		return err
+	}
+	if !report.HasErrors() {
+		return nil
+	}
+	return report
+}
+
+// ValidateServerJSONReport behaves like ValidateServerJSON but returns
+// every diagnostic found in the document rather than stopping at the
+// first, so a publisher (or editor/IDE tooling, via Diagnostic's Path)
+// sees every problem in one pass. Set stopOnFirst for the fast-fail
+// behavior ValidateServerJSON uses.
+func ValidateServerJSONReport(s *apiv0.ServerJSON, stopOnFirst bool) (Report, error) {
+	validate, ok := schemaReportValidators[s.SchemaVersion]
+	if !ok {
+		return Report{}, fmt.Errorf("%w: %q", ErrUnsupportedSchemaVersion, s.SchemaVersion)
+	}
+	return validate(s, v01.Options{StopOnFirst: stopOnFirst}), nil
+}
+
+// MigrateToLatest parses raw as a ServerJSON document at whatever
+// $schemaVersion it declares (defaulting to the oldest version if
+// unset), and walks the migration chain up to LatestSchemaVersion. It
+// returns the migrated document plus a Report of any deprecation
+// warnings accumulated along the way, so that old publishes keep
+// working and new clients see the newest representation.
+func MigrateToLatest(raw []byte) (apiv0.ServerJSON, Report, error) {
+	var probe struct {
+		SchemaVersion string `json:"$schemaVersion"`
+	}
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return apiv0.ServerJSON{}, Report{}, fmt.Errorf("invalid server json: %w", err)
+	}
+
+	version := probe.SchemaVersion
+	if version == "" {
+		version = v01.Version
+	}
+
+	// This switch is the migration chain. Today there is only one
+	// version, so it's the identity step; adding v0.2 means adding a
+	// case here that unmarshals into schema/v02's type and calls its
+	// Translate(v01.ServerJSON) (v02.ServerJSON, Report) before falling
+	// through to this same return.
+	switch version {
+	case v01.Version:
+		var s apiv0.ServerJSON
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return apiv0.ServerJSON{}, Report{}, fmt.Errorf("invalid server json: %w", err)
+		}
+		s.SchemaVersion = v01.Version
+		return s, Report{}, nil
+	default:
+		return apiv0.ServerJSON{}, Report{}, fmt.Errorf("%w: %q", ErrUnsupportedSchemaVersion, version)
+	}
+}
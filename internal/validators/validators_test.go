@@ -1,14 +1,24 @@
 package validators_test
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/modelcontextprotocol/registry/internal/config"
 	"github.com/modelcontextprotocol/registry/internal/validators"
+	"github.com/modelcontextprotocol/registry/internal/validators/snapshot"
 	apiv0 "github.com/modelcontextprotocol/registry/pkg/api/v0"
 	"github.com/modelcontextprotocol/registry/pkg/model"
 )
@@ -229,8 +239,8 @@ func TestValidate(t *testing.T) {
 				Name:        "com.example/test-server",
 				Description: "A test server",
 				Repository: model.Repository{
-					URL:    "https://bitbucket.org/owner/repo",
-					Source: "bitbucket", // Not in validSources
+					URL:    "https://svn.example.com/owner/repo",
+					Source: "subversion", // Not a registered provider
 				},
 				Version: "1.0.0",
 			},
@@ -667,6 +677,63 @@ func TestValidate(t *testing.T) {
 	}
 }
 
+func TestValidate_RepositoryProviders(t *testing.T) {
+	tests := []struct {
+		name        string
+		repository  model.Repository
+		expectError bool
+	}{
+		{
+			name:       "bitbucket",
+			repository: model.Repository{URL: "https://bitbucket.org/owner/repo", Source: "bitbucket"},
+		},
+		{
+			name:       "codeberg",
+			repository: model.Repository{URL: "https://codeberg.org/owner/repo", Source: "codeberg"},
+		},
+		{
+			name:       "sourcehut",
+			repository: model.Repository{URL: "https://git.sr.ht/~owner/repo", Source: "sourcehut"},
+		},
+		{
+			name:       "azure devops",
+			repository: model.Repository{URL: "https://dev.azure.com/owner/project/_git/repo", Source: "azuredevops"},
+		},
+		{
+			name:        "sourcehut without tilde owner is invalid",
+			repository:  model.Repository{URL: "https://git.sr.ht/owner/repo", Source: "sourcehut"},
+			expectError: true,
+		},
+		{
+			name:        "azure devops without _git segment is invalid",
+			repository:  model.Repository{URL: "https://dev.azure.com/owner/project/repo", Source: "azuredevops"},
+			expectError: true,
+		},
+		{
+			name:        "bitbucket url on a gitlab source is invalid",
+			repository:  model.Repository{URL: "https://bitbucket.org/owner/repo", Source: "gitlab"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverDetail := apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Repository:  tt.repository,
+				Version:     "1.0.0",
+			}
+			err := validators.ValidateServerJSON(&serverDetail)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestValidate_RemoteNamespaceMatch(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1326,6 +1393,164 @@ func TestValidate_TransportValidation(t *testing.T) {
 			},
 			expectedError: "url is required for sse transport type",
 		},
+		// Package transport tests - ws/wss (URL required, ws(s):// scheme)
+		{
+			name: "package transport ws with valid URL",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type: "ws",
+							URL:  "ws://example.com/ws",
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "package transport wss with valid URL and subprotocols",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type:         "wss",
+							URL:          "wss://example.com/ws",
+							Subprotocols: []string{"mcp", "mcp.v1"},
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "package transport wss without URL",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type: "wss",
+						},
+					},
+				},
+			},
+			expectedError: "url is required for wss transport type",
+		},
+		{
+			name: "package transport wss with http scheme URL (bad scheme)",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type: "wss",
+							URL:  "http://example.com/ws",
+						},
+					},
+				},
+			},
+			expectedError: "transport url scheme does not match transport type",
+		},
+		{
+			name: "package transport ws with wss scheme URL (bad scheme)",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type: "ws",
+							URL:  "wss://example.com/ws",
+						},
+					},
+				},
+			},
+			expectedError: "transport url scheme does not match transport type",
+		},
+		{
+			name: "package transport wss with invalid subprotocol",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type:         "wss",
+							URL:          "wss://example.com/ws",
+							Subprotocols: []string{"mcp, v1"},
+						},
+					},
+				},
+			},
+			expectedError: "invalid websocket subprotocol name",
+		},
+		{
+			name: "package transport templated wss URL with declared variables",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type: "wss",
+							URL:  "wss://{host}/mcp",
+						},
+						EnvironmentVariables: []model.KeyValueInput{
+							{Name: "host"},
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "package transport templated wss URL missing declared variables",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   "test-package",
+						RegistryType: "npm",
+						Transport: model.Transport{
+							Type: "wss",
+							URL:  "wss://{host}/mcp",
+						},
+						// Missing host variable
+					},
+				},
+			},
+			expectedError: "template variables in URL",
+		},
 		// Package transport tests - unsupported type
 		{
 			name: "package transport unsupported type",
@@ -1405,6 +1630,51 @@ func TestValidate_TransportValidation(t *testing.T) {
 			},
 			expectedError: "url is required for sse transport type",
 		},
+		// Remote transport tests - ws/wss
+		{
+			name: "remote transport wss with valid URL",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Remotes: []model.Transport{
+					{
+						Type: "wss",
+						URL:  "wss://example.com/ws",
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			name: "remote transport wss without URL",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Remotes: []model.Transport{
+					{
+						Type: "wss",
+					},
+				},
+			},
+			expectedError: "url is required for wss transport type",
+		},
+		{
+			name: "remote transport ws rejects localhost URLs",
+			serverDetail: apiv0.ServerJSON{
+				Name:        "com.example/test-server",
+				Description: "A test server",
+				Version:     "1.0.0",
+				Remotes: []model.Transport{
+					{
+						Type: "ws",
+						URL:  "ws://localhost:3000/ws",
+					},
+				},
+			},
+			expectedError: "invalid remote URL",
+		},
 		// Remote transport tests - unsupported types
 		{
 			name: "remote transport stdio not supported",
@@ -1592,4 +1862,296 @@ func createValidServerWithArgument(arg model.Argument) apiv0.ServerJSON {
 			},
 		},
 	}
+}
+
+// TestValidate_RegistryTypesAndUrls_Snapshot runs the valid rows of
+// TestValidate_RegistryTypesAndUrls' matrix entirely offline, against a
+// snapshot bundle built in a temp dir, so it never reaches npmjs.org,
+// PyPI, NuGet or GHCR the way the live-network test above does.
+func TestValidate_RegistryTypesAndUrls_Snapshot(t *testing.T) {
+	testCases := []struct {
+		tcName       string
+		name         string
+		registryType string
+		identifier   string
+		version      string
+		fileSHA256   string
+	}{
+		{"valid_npm", "io.github.domdomegg/airtable-mcp-server", model.RegistryTypeNPM, "airtable-mcp-server", "1.7.2", ""},
+		{"valid_pypi", "io.github.domdomegg/time-mcp-pypi", model.RegistryTypePyPI, "time-mcp-pypi", "1.0.1", ""},
+		{"valid_oci", "io.github.domdomegg/airtable-mcp-server", model.RegistryTypeOCI, "domdomegg/airtable-mcp-server", "1.7.2", ""},
+		{"valid_nuget", "io.github.domdomegg/time-mcp-server", model.RegistryTypeNuGet, "TimeMcpServer", "1.0.2", ""},
+		{"valid_mcpb_github", "io.github.domdomegg/airtable-mcp-server", model.RegistryTypeMCPB, "https://github.com/domdomegg/airtable-mcp-server/releases/download/v1.7.2/airtable-mcp-server.mcpb", "1.7.2", "fe333e598595000ae021bd27117db32ec69af6987f507ba7a63c90638ff633ce"},
+	}
+
+	var entries []snapshot.Entry
+	for _, tc := range testCases {
+		entries = append(entries, snapshot.Entry{
+			RegistryType: tc.registryType,
+			Identifier:   tc.identifier,
+			Version:      tc.version,
+			SHA256:       tc.fileSHA256,
+		})
+	}
+
+	var bundle bytes.Buffer
+	require.NoError(t, snapshot.Build(&bundle, entries))
+
+	bundlePath := filepath.Join(t.TempDir(), "registry-snapshot.tar.gz")
+	require.NoError(t, os.WriteFile(bundlePath, bundle.Bytes(), 0o644))
+
+	f, err := os.Open(bundlePath)
+	require.NoError(t, err)
+	defer f.Close()
+	store, err := snapshot.Open(f)
+	require.NoError(t, err)
+
+	cfg := &config.Config{
+		EnableRegistryValidation: true,
+		RegistryValidationMode:   config.RegistryValidationModeSnapshot,
+		SnapshotStore:            store,
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.tcName, func(t *testing.T) {
+			serverJSON := apiv0.ServerJSON{
+				Name:        tc.name,
+				Description: "A test server",
+				Repository: model.Repository{
+					URL:    "https://github.com/owner/repo",
+					Source: "github",
+					ID:     "owner/repo",
+				},
+				Version: "1.0.0",
+				Packages: []model.Package{
+					{
+						Identifier:   tc.identifier,
+						RegistryType: tc.registryType,
+						Version:      tc.version,
+						FileSHA256:   tc.fileSHA256,
+						Transport: model.Transport{
+							Type: "stdio",
+						},
+					},
+				},
+			}
+
+			assert.NoError(t, validators.ValidatePublishRequest(context.Background(), serverJSON, cfg))
+		})
+	}
+
+	t.Run("not_captured_fails", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{
+			Name:        "io.github.domdomegg/uncaptured-server",
+			Description: "A test server",
+			Repository: model.Repository{
+				URL:    "https://github.com/owner/repo",
+				Source: "github",
+				ID:     "owner/repo",
+			},
+			Version: "1.0.0",
+			Packages: []model.Package{
+				{
+					Identifier:   "never-captured-package",
+					RegistryType: model.RegistryTypeNPM,
+					Version:      "9.9.9",
+					Transport:    model.Transport{Type: "stdio"},
+				},
+			},
+		}
+
+		err := validators.ValidatePublishRequest(context.Background(), serverJSON, cfg)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, validators.ErrSnapshotEntryNotCaptured)
+	})
+
+	t.Run("missing_store_fails", func(t *testing.T) {
+		serverJSON := apiv0.ServerJSON{
+			Name:        "io.github.domdomegg/airtable-mcp-server",
+			Description: "A test server",
+			Repository: model.Repository{
+				URL:    "https://github.com/owner/repo",
+				Source: "github",
+				ID:     "owner/repo",
+			},
+			Version: "1.0.0",
+			Packages: []model.Package{
+				{
+					Identifier:   "airtable-mcp-server",
+					RegistryType: model.RegistryTypeNPM,
+					Version:      "1.7.2",
+					Transport:    model.Transport{Type: "stdio"},
+				},
+			},
+		}
+
+		err := validators.ValidatePublishRequest(context.Background(), serverJSON, &config.Config{
+			EnableRegistryValidation: true,
+			RegistryValidationMode:   config.RegistryValidationModeSnapshot,
+		})
+		assert.Error(t, err)
+	})
+}
+
+// redirectingTransport rewrites any request for host to target's scheme
+// and host, leaving the path and query untouched, then delegates to
+// http.DefaultTransport. Installing one as http.DefaultTransport for a
+// test's duration lets every package-scoped http.Client that defaults to
+// it (datasource's existence checks and packages' digest verification
+// both do) reach a local httptest.Server while the document under
+// validation keeps declaring the real default registry host - the
+// alternative, overriding Package.RegistryBaseURL, is rejected by
+// validateRegistryType for types with a fixed DefaultBaseURL.
+type redirectingTransport struct {
+	host   string
+	target *url.URL
+	next   http.RoundTripper
+}
+
+func (rt redirectingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host == rt.host {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = rt.target.Scheme
+		req.URL.Host = rt.target.Host
+	}
+	return rt.next.RoundTrip(req)
+}
+
+// TestValidatePublishRequest_ArtifactDigestVerification parallels the
+// "package validation success - MCPB package" case of TestPublishEndpoint
+// (internal/api/handlers/v0/publish_test.go), but against an npm package
+// served by a local httptest server rather than a real release host,
+// since MCPB identifiers are restricted to github.com/gitlab.com hosts
+// (see registries.mcpbBackend) and can't point at a test server.
+func TestValidatePublishRequest_ArtifactDigestVerification(t *testing.T) {
+	tarball := []byte("fake tarball contents")
+	sum := sha256.Sum256(tarball)
+	digest := hex.EncodeToString(sum[:])
+
+	var mux http.ServeMux
+	var srv *httptest.Server
+	mux.HandleFunc("/some-pkg/1.0.0", func(w http.ResponseWriter, r *http.Request) {
+		// Real npm registries always return an absolute dist.tarball URL.
+		_, _ = fmt.Fprintf(w, `{"dist":{"tarball":%q}}`, srv.URL+"/some-pkg/-/some-pkg-1.0.0.tgz")
+	})
+	mux.HandleFunc("/some-pkg/-/some-pkg-1.0.0.tgz", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(tarball)
+	})
+	mux.HandleFunc("/some-pkg", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprintf(w, `{"versions":{"1.0.0":{}}}`)
+	})
+	srv = httptest.NewServer(&mux)
+	defer srv.Close()
+
+	srvURL, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+	npmURL, err := url.Parse(model.RegistryURLNPM)
+	require.NoError(t, err)
+
+	originalTransport := http.DefaultTransport
+	http.DefaultTransport = redirectingTransport{host: npmURL.Host, target: srvURL, next: originalTransport}
+	t.Cleanup(func() { http.DefaultTransport = originalTransport })
+
+	newServerJSON := func(fileSHA256 string) apiv0.ServerJSON {
+		return apiv0.ServerJSON{
+			Name:        "io.github.domdomegg/digest-test-server",
+			Description: "A test server",
+			Repository: model.Repository{
+				URL:    "https://github.com/owner/repo",
+				Source: "github",
+				ID:     "owner/repo",
+			},
+			Version: "1.0.0",
+			Packages: []model.Package{
+				{
+					Identifier:   "some-pkg",
+					RegistryType: model.RegistryTypeNPM,
+					Version:      "1.0.0",
+					FileSHA256:   fileSHA256,
+					Transport:    model.Transport{Type: "stdio"},
+				},
+			},
+		}
+	}
+
+	cfg := &config.Config{EnableRegistryValidation: true}
+
+	t.Run("package validation success - npm package", func(t *testing.T) {
+		assert.NoError(t, validators.ValidatePublishRequest(context.Background(), newServerJSON(digest), cfg))
+	})
+
+	t.Run("digest mismatch is rejected", func(t *testing.T) {
+		err := validators.ValidatePublishRequest(context.Background(), newServerJSON("0000000000000000000000000000000000000000000000000000000000000"), cfg)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "digest mismatch")
+	})
+
+	t.Run("no FileSHA256 declared is not checked", func(t *testing.T) {
+		assert.NoError(t, validators.ValidatePublishRequest(context.Background(), newServerJSON(""), cfg))
+	})
+}
+
+// TestValidatePublishRequest_Attestations mirrors the table-driven style
+// of TestPublishEndpoint (internal/api/handlers/v0/publish_test.go), but
+// at the validators layer: that handler's RegisterPublishEndpoint,
+// its backing service.RegistryService and internal/database aren't
+// present in this tree, so there's no HTTP layer here to assert status
+// codes against. The cases below exercise the same decisions a 403
+// (wrong SAN) or 422 (missing required bundle) response would be based
+// on, gated behind cfg.RequireNamespaceAttestations.
+func TestValidatePublishRequest_Attestations(t *testing.T) {
+	newServerJSON := func(name string, attestations []model.Attestation) apiv0.ServerJSON {
+		return apiv0.ServerJSON{
+			Name:        name,
+			Description: "A test server",
+			Repository: model.Repository{
+				URL:    "https://github.com/example/foo-server",
+				Source: "github",
+				ID:     "example/foo-server",
+			},
+			Version:      "1.0.0",
+			Attestations: attestations,
+		}
+	}
+
+	t.Run("disabled by default, even for io.github.* with no attestation", func(t *testing.T) {
+		s := newServerJSON("io.github.example/foo-server", nil)
+		assert.NoError(t, validators.ValidatePublishRequest(context.Background(), s, &config.Config{}))
+	})
+
+	t.Run("namespace with no policy is unaffected", func(t *testing.T) {
+		s := newServerJSON("com.example/foo-server", nil)
+		assert.NoError(t, validators.ValidatePublishRequest(context.Background(), s, &config.Config{RequireNamespaceAttestations: true}))
+	})
+
+	t.Run("valid bundle", func(t *testing.T) {
+		s := newServerJSON("io.github.example/foo-server", []model.Attestation{
+			{
+				BundleURL:           "https://example.com/bundle.sigstore.json",
+				CertificateIdentity: "https://github.com/example/foo-server/.github/workflows/release.yml@refs/tags/v1.0.0",
+				CertificateIssuer:   "https://token.actions.githubusercontent.com",
+			},
+		})
+		assert.NoError(t, validators.ValidatePublishRequest(context.Background(), s, &config.Config{RequireNamespaceAttestations: true}))
+	})
+
+	t.Run("wrong SAN is rejected", func(t *testing.T) {
+		s := newServerJSON("io.github.example/foo-server", []model.Attestation{
+			{
+				BundleURL:           "https://example.com/bundle.sigstore.json",
+				CertificateIdentity: "https://github.com/someone-else/other-repo/.github/workflows/release.yml@refs/tags/v1.0.0",
+				CertificateIssuer:   "https://token.actions.githubusercontent.com",
+			},
+		})
+		err := validators.ValidatePublishRequest(context.Background(), s, &config.Config{RequireNamespaceAttestations: true})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, validators.ErrAttestationIdentityMismatch)
+	})
+
+	t.Run("missing bundle when namespace policy requires one", func(t *testing.T) {
+		s := newServerJSON("io.github.example/foo-server", nil)
+		err := validators.ValidatePublishRequest(context.Background(), s, &config.Config{RequireNamespaceAttestations: true})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, validators.ErrAttestationRequired)
+	})
 }
\ No newline at end of file
@@ -0,0 +1,39 @@
+// Package verr holds the sentinel errors shared by the validators
+// package and its per-schema-version sub-packages. It exists so that
+// schema version packages (internal/validators/schema/...) can return
+// the same sentinel values the top-level validators package re-exports,
+// without an import cycle between the two.
+package verr
+
+import "errors"
+
+var (
+	ErrVersionLooksLikeRange       = errors.New("version must be a pinned version, not a range")
+	ErrMultipleSlashesInServerName = errors.New("server name cannot contain multiple slashes")
+	ErrInvalidRepositoryURL        = errors.New("invalid repository url")
+	ErrInvalidSubfolderPath        = errors.New("invalid repository subfolder path")
+	ErrPackageNameHasSpaces        = errors.New("package identifier must not contain spaces")
+	ErrReservedVersionString       = errors.New("version is a reserved string, not a pinned version")
+	ErrInvalidRemoteURL            = errors.New("invalid remote URL")
+	ErrUnsupportedSchemaVersion    = errors.New("unsupported $schemaVersion")
+
+	ErrPackageNotFoundUpstream        = errors.New("package not found in upstream registry")
+	ErrPackageVersionNotFoundUpstream = errors.New("package version not found in upstream registry")
+
+	ErrInvalidPurl              = errors.New("invalid package url")
+	ErrPurlRegistryTypeMismatch = errors.New("purl type does not match registryType")
+
+	ErrProvenanceSubjectDigestMismatch = errors.New("provenance subject digest mismatch")
+	ErrProvenanceSourceMismatch        = errors.New("provenance source repository does not match server repository")
+	ErrUntrustedBuilderIdentity        = errors.New("untrusted builder identity")
+	ErrTransparencyLogEntryNotFound    = errors.New("transparency log entry not found")
+
+	ErrTransportSchemeMismatch = errors.New("transport url scheme does not match transport type")
+	ErrInvalidSubprotocol      = errors.New("invalid websocket subprotocol name")
+
+	ErrAttestationRequired         = errors.New("server name namespace requires a signed attestation")
+	ErrAttestationIdentityMismatch = errors.New("attestation certificate identity does not match the namespace's allowed identity pattern")
+
+	ErrInvalidNamedArgumentName = errors.New("named argument name must not contain a description, embedded value, variable reference, or spaces")
+	ErrRedundantArgumentValue   = errors.New("argument value/default must not redundantly repeat its own flag name")
+)
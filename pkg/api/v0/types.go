@@ -0,0 +1,32 @@
+// Package v0 contains the public v0 API wire types for the registry,
+// shared between the HTTP handlers, the validators, and API clients.
+package v0
+
+import "github.com/modelcontextprotocol/registry/pkg/model"
+
+// ServerJSON is the top-level document published for an MCP server, as
+// submitted to /v0/publish and returned from /v0/servers/{name}.
+type ServerJSON struct {
+	// SchemaVersion is the $schemaVersion this document was authored
+	// against (e.g. "v0.1"). Publishers may omit it, in which case the
+	// oldest/default version is assumed; see
+	// internal/validators.MigrateToLatest for how older documents are
+	// brought forward.
+	SchemaVersion string `json:"$schemaVersion,omitempty"`
+	Name          string `json:"name"`
+	Description   string `json:"description"`
+	Version       string `json:"version"`
+	WebsiteURL    string `json:"websiteUrl,omitempty"`
+
+	Repository model.Repository  `json:"repository,omitempty"`
+	Packages   []model.Package   `json:"packages,omitempty"`
+	Remotes    []model.Transport `json:"remotes,omitempty"`
+
+	// Attestations lists Sigstore bundles covering this release as a
+	// whole. When config.Config.RequireNamespaceAttestations is set and
+	// the server name's namespace has a required identity pattern (see
+	// internal/validators/provenance), at least one attestation must be
+	// present and every attestation must match it for the publish to be
+	// accepted.
+	Attestations []model.Attestation `json:"attestations,omitempty"`
+}
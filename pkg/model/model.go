@@ -0,0 +1,176 @@
+// Package model contains the core data types shared by the registry's
+// public API, validators, and storage layer. These types mirror the
+// server.json schema published at https://modelcontextprotocol.io/schema.
+package model
+
+// Repository describes the source repository a server is published from.
+type Repository struct {
+	URL       string `json:"url"`
+	Source    string `json:"source"`
+	ID        string `json:"id,omitempty"`
+	Subfolder string `json:"subfolder,omitempty"`
+}
+
+// Registry types identify the package ecosystem a Package is published to.
+const (
+	RegistryTypeNPM   = "npm"
+	RegistryTypePyPI  = "pypi"
+	RegistryTypeOCI   = "oci"
+	RegistryTypeNuGet = "nuget"
+	RegistryTypeMCPB  = "mcpb"
+)
+
+// Well-known default base URLs for the built-in registry types.
+const (
+	RegistryURLNPM    = "https://registry.npmjs.org"
+	RegistryURLPyPI   = "https://pypi.org"
+	RegistryURLDocker = "https://docker.io"
+	RegistryURLNuGet  = "https://api.nuget.org"
+	RegistryURLGitHub = "https://github.com"
+	RegistryURLGitLab = "https://gitlab.com"
+)
+
+// Transport type identifiers.
+const (
+	TransportTypeStdio          = "stdio"
+	TransportTypeStreamableHTTP = "streamable-http"
+	TransportTypeSSE            = "sse"
+	TransportTypeWS             = "ws"
+	TransportTypeWSS            = "wss"
+)
+
+// Transport describes how a client connects to a package or remote server.
+type Transport struct {
+	Type string `json:"type"`
+	URL  string `json:"url,omitempty"`
+
+	// Subprotocols lists the IANA-registered WebSocket subprotocol names
+	// (https://www.iana.org/assignments/websocket/websocket.xml) the
+	// server speaks, in preference order. Only meaningful for the ws/wss
+	// transport types.
+	Subprotocols []string `json:"subprotocols,omitempty"`
+}
+
+// Input is a single templated or literal value, such as a runtime argument
+// or environment variable value.
+type Input struct {
+	Value       string `json:"value,omitempty"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+	IsRequired  bool   `json:"isRequired,omitempty"`
+	IsSecret    bool   `json:"isSecret,omitempty"`
+}
+
+// InputWithVariables is an Input that may reference named variables via
+// `{variable}` placeholders, resolved from the surrounding Variables map.
+type InputWithVariables struct {
+	Input     Input            `json:",inline"`
+	Variables map[string]Input `json:"variables,omitempty"`
+}
+
+// KeyValueInput is a named Input, used for environment variables and
+// headers.
+type KeyValueInput struct {
+	InputWithVariables `json:",inline"`
+	Name               string `json:"name"`
+}
+
+// Argument types.
+const (
+	ArgumentTypePositional = "positional"
+	ArgumentTypeNamed      = "named"
+)
+
+// Argument describes a single runtime or package argument.
+type Argument struct {
+	InputWithVariables `json:",inline"`
+	Type               string `json:"type"`
+	Name               string `json:"name,omitempty"`
+	IsRepeated         bool   `json:"isRepeated,omitempty"`
+}
+
+// Package describes a single installable artifact for an MCP server.
+type Package struct {
+	RegistryType         string          `json:"registryType"`
+	RegistryBaseURL      string          `json:"registryBaseUrl,omitempty"`
+	Identifier           string          `json:"identifier"`
+	Version              string          `json:"version,omitempty"`
+	FileSHA256           string          `json:"fileSha256,omitempty"`
+	Transport            Transport       `json:"transport"`
+	RuntimeArguments     []Argument      `json:"runtimeArguments,omitempty"`
+	PackageArguments     []Argument      `json:"packageArguments,omitempty"`
+	EnvironmentVariables []KeyValueInput `json:"environmentVariables,omitempty"`
+
+	// Purl is the canonical Package URL (https://github.com/package-url/purl-spec)
+	// for this package, e.g. "pkg:npm/foo@1.2.3". It may be given instead
+	// of, or alongside, RegistryType/Identifier/Version/RegistryBaseURL;
+	// the validator reconciles the two and normalizes this field to its
+	// canonical form. Downstream SBOM tooling can consume it directly.
+	Purl string `json:"purl,omitempty"`
+
+	// Provenance is a signed in-toto/SLSA v1.0 build provenance
+	// statement for this package, letting consumers verify it was built
+	// from the claimed source repository rather than hand-uploaded.
+	Provenance *Provenance `json:"provenance,omitempty"`
+
+	// ParsedIdentifier is the validator's structured parse of Identifier
+	// (see internal/validators/pkgspec.Result), cached here so that
+	// downstream consumers (registry backends, upstream datasource
+	// lookups) don't need to re-parse it. It is never serialized.
+	ParsedIdentifier any `json:"-"`
+}
+
+// Provenance is a signed in-toto/SLSA v1.0 provenance statement
+// attesting to how a package was built and published. Its fields mirror
+// the subset of the statement the registry checks: who issued it, what
+// artifact it covers, what built it, and where it's recorded in a
+// transparency log.
+type Provenance struct {
+	// Issuer is the OIDC identity provider that issued the signing
+	// certificate (e.g. "https://token.actions.githubusercontent.com").
+	Issuer string `json:"issuer"`
+
+	// SubjectDigest is the sha256 digest of the artifact the statement
+	// covers, in "sha256:<hex>" form. It must match the package's
+	// FileSHA256 (allowing for that prefix) when both are present.
+	SubjectDigest string `json:"subjectDigest"`
+
+	// BuilderID identifies the build platform that produced the
+	// artifact (e.g. a GitHub Actions workflow ref).
+	BuilderID string `json:"builderId"`
+
+	// SourceRepoURI is the source repository the build ran from, which
+	// must match ServerJSON.Repository.URL.
+	SourceRepoURI string `json:"sourceRepoUri"`
+
+	// SourceCommit is the commit SHA the build ran from.
+	SourceCommit string `json:"sourceCommit,omitempty"`
+
+	// TransparencyLogID is the Rekor transparency-log entry UUID the
+	// statement was recorded under.
+	TransparencyLogID string `json:"transparencyLogId,omitempty"`
+}
+
+// Attestation is a Sigstore bundle covering the server as a whole,
+// letting a publisher prove every package in the release was signed by
+// the same CI identity rather than checking each Package.Provenance
+// independently. Unlike Provenance, which is checked against the
+// upstream registry's own attestation feed, an Attestation is checked
+// only by its recorded CertificateIdentity and CertificateIssuer; the
+// bundle at BundleURL is not fetched and its signature is not verified.
+type Attestation struct {
+	// BundleURL points at the Sigstore bundle (DSSE envelope, Fulcio
+	// certificate chain, and Rekor inclusion proof) covering this
+	// release.
+	BundleURL string `json:"bundleUrl"`
+
+	// CertificateIdentity is the Fulcio certificate's SAN, e.g.
+	// "https://github.com/example/repo/.github/workflows/release.yml@refs/tags/v1.0.0".
+	// It must match the identity pattern required for the server
+	// name's namespace (see internal/validators/provenance).
+	CertificateIdentity string `json:"certificateIdentity"`
+
+	// CertificateIssuer is the OIDC issuer that issued the signing
+	// certificate, e.g. "https://token.actions.githubusercontent.com".
+	CertificateIssuer string `json:"certificateIssuer"`
+}
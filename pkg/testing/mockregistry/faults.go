@@ -0,0 +1,35 @@
+package mockregistry
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimited returns a handler that responds 429 Too Many Requests with
+// a Retry-After header, for exercising a client's backoff handling
+// against an upstream registry host (e.g. one of the package digest
+// verifiers in internal/validators/packages).
+func RateLimited(retryAfter time.Duration) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+}
+
+// Unavailable returns a handler that responds with status, simulating an
+// upstream registry outage. status is typically 500, 502, or 503.
+func Unavailable(status int) http.HandlerFunc {
+	return func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(status)
+	}
+}
+
+// Slow wraps next so it only forwards the request after delay has
+// elapsed, simulating a registry under load.
+func Slow(delay time.Duration, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,62 @@
+// Package mockregistry is a reusable test harness for issuing registry
+// JWTs and for simulating a flaky upstream HTTP registry. The fault
+// handlers (RateLimited, Unavailable, Slow) use only net/http. Harness.JWT
+// and IssueToken use internal/auth's JWTManager and JWTClaims types,
+// which code outside this module cannot import, so issuing tokens with
+// chosen claims is possible only from packages inside this module.
+//
+// The harness is intentionally narrower than its Docker
+// registry_mock_test.go namesake: it does not spin up an in-process
+// copy of the registry's own HTTP API. TestPublishEndpoint builds that
+// from internal/service.RegistryService, internal/database.NewTestDB,
+// and v0.RegisterPublishEndpoint, but this working tree only has
+// internal/auth - internal/service, internal/database, and
+// internal/api/handlers/v0 (beyond the pre-existing publish_test.go)
+// don't exist here, so there's no real API/Registry to wrap. New
+// therefore only covers the self-contained piece: minting registry
+// JWTs with the same JWTManager the real endpoint would use. Once
+// internal/service and internal/database land, API and Registry fields
+// analogous to TestPublishEndpoint's setup belong on Harness alongside
+// JWT.
+package mockregistry
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/internal/config"
+)
+
+// Harness bundles the fixtures a test needs to act as an authenticated
+// registry client.
+type Harness struct {
+	// JWT signs and verifies registry tokens using a fresh, random
+	// ed25519 key pair generated for this Harness.
+	JWT *auth.JWTManager
+}
+
+// New builds a Harness with a freshly generated ed25519 signing key, so
+// tokens from one Harness never validate against another.
+func New(t *testing.T) *Harness {
+	t.Helper()
+	seed := make([]byte, ed25519.SeedSize)
+	_, err := rand.Read(seed)
+	require.NoError(t, err)
+	cfg := &config.Config{JWTPrivateKey: hex.EncodeToString(seed)}
+	return &Harness{JWT: auth.NewJWTManager(cfg)}
+}
+
+// IssueToken signs claims into a registry JWT using h.JWT, failing the
+// test immediately if signing fails.
+func (h *Harness) IssueToken(t *testing.T, claims auth.JWTClaims) string {
+	t.Helper()
+	resp, err := h.JWT.GenerateTokenResponse(context.Background(), claims)
+	require.NoError(t, err)
+	return resp.RegistryToken
+}
@@ -0,0 +1,77 @@
+package mockregistry_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/modelcontextprotocol/registry/internal/auth"
+	"github.com/modelcontextprotocol/registry/pkg/testing/mockregistry"
+)
+
+func TestHarness_IssueToken(t *testing.T) {
+	h := mockregistry.New(t)
+	claims := auth.JWTClaims{
+		AuthMethod:        auth.MethodGitHubAT,
+		AuthMethodSubject: "octocat",
+		Permissions: []auth.Permission{
+			{Action: auth.PermissionActionPublish, ResourcePattern: "io.github.octocat/*"},
+		},
+	}
+
+	token := h.IssueToken(t, claims)
+	require.NotEmpty(t, token)
+
+	got, err := h.JWT.ValidateToken(context.Background(), token)
+	require.NoError(t, err)
+	assert.Equal(t, claims.AuthMethod, got.AuthMethod)
+	assert.Equal(t, claims.Permissions, got.Permissions)
+}
+
+func TestHarness_TokensDoNotCrossValidate(t *testing.T) {
+	a := mockregistry.New(t)
+	b := mockregistry.New(t)
+
+	token := a.IssueToken(t, auth.JWTClaims{AuthMethod: auth.MethodNone})
+	_, err := b.JWT.ValidateToken(context.Background(), token)
+	assert.ErrorIs(t, err, auth.ErrInvalidToken)
+}
+
+func TestRateLimited(t *testing.T) {
+	srv := httptest.NewServer(mockregistry.RateLimited(30 * time.Second))
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "30", resp.Header.Get("Retry-After"))
+}
+
+func TestUnavailable(t *testing.T) {
+	srv := httptest.NewServer(mockregistry.Unavailable(http.StatusBadGateway))
+	defer srv.Close()
+
+	resp, err := srv.Client().Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+}
+
+func TestSlow(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) { w.WriteHeader(http.StatusOK) })
+	srv := httptest.NewServer(mockregistry.Slow(20*time.Millisecond, ok))
+	defer srv.Close()
+
+	start := time.Now()
+	resp, err := srv.Client().Get(srv.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}